@@ -69,6 +69,11 @@ func dataSourceGitlabGroup() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"custom_attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -86,13 +91,13 @@ func dataSourceGitlabGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	if groupIDOk {
 		// Get group by id
-		group, _, err = client.Groups.GetGroup(groupIDData.(int))
+		group, _, err = client.Groups.GetGroup(groupIDData.(int), withCustomAttributes)
 		if err != nil {
 			return err
 		}
 	} else if fullPathOk {
 		// Get group by full path
-		group, _, err = client.Groups.GetGroup(fullPathData.(string))
+		group, _, err = client.Groups.GetGroup(fullPathData.(string), withCustomAttributes)
 		if err != nil {
 			return err
 		}
@@ -112,6 +117,7 @@ func dataSourceGitlabGroupRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("visibility_level", group.Visibility)
 	d.Set("parent_id", group.ParentID)
 	d.Set("runners_token", group.RunnersToken)
+	d.Set("custom_attributes", flattenCustomAttributes(group.CustomAttributes))
 
 	d.SetId(fmt.Sprintf("%d", group.ID))
 
@@ -0,0 +1,78 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+func dataSourceGitlabGroupSamlIdentities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitlabGroupSamlIdentitiesRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "saml",
+				ValidateFunc: validation.StringInSlice(groupIdentityTypes, false),
+			},
+			"identities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"extern_uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabGroupSamlIdentitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group_id").(string)
+	identityType := d.Get("type").(string)
+
+	log.Printf("[INFO] Reading Gitlab group %s %s identities", group, identityType)
+
+	identities, _, err := listGroupIdentities(client, group, identityType)
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, 0, len(identities))
+	for _, identity := range identities {
+		values = append(values, map[string]interface{}{
+			"user_id":    identity.UserID,
+			"extern_uid": identity.ExternUID,
+			"active":     identity.Active == nil || *identity.Active,
+		})
+	}
+
+	if err := d.Set("identities", values); err != nil {
+		return fmt.Errorf("error setting identities: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", group, identityType))
+
+	return nil
+}
@@ -99,6 +99,11 @@ func dataSourceGitlabProject() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"custom_attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"push_rules": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -163,7 +168,9 @@ func dataSourceGitlabProjectRead(d *schema.ResourceData, meta interface{}) error
 
 	v, _ := d.GetOk("id")
 
-	found, _, err := client.Projects.GetProject(v, nil)
+	found, _, err := client.Projects.GetProject(v, &gitlab.GetProjectOptions{
+		WithCustomAttributes: gitlab.Bool(true),
+	})
 	if err != nil {
 		return err
 	}
@@ -188,6 +195,7 @@ func dataSourceGitlabProjectRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("runners_token", found.RunnersToken)
 	d.Set("archived", found.Archived)
 	d.Set("remove_source_branch_after_merge", found.RemoveSourceBranchAfterMerge)
+	d.Set("custom_attributes", flattenCustomAttributes(found.CustomAttributes))
 
 	log.Printf("[DEBUG] Reading Gitlab project %q push rules", d.Id())
 
@@ -136,6 +136,11 @@ func dataSourceGitlabUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"custom_attributes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -154,7 +159,7 @@ func dataSourceGitlabUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	if userIDOk {
 		// Get user by id
-		user, _, err = client.Users.GetUser(userIDData.(int))
+		user, _, err = client.Users.GetUser(userIDData.(int), withCustomAttributes)
 		if err != nil {
 			return err
 		}
@@ -162,7 +167,9 @@ func dataSourceGitlabUserRead(d *schema.ResourceData, meta interface{}) error {
 		username := strings.ToLower(usernameData.(string))
 		email := strings.ToLower(emailData.(string))
 
-		listUsersOptions := &gitlab.ListUsersOptions{}
+		listUsersOptions := &gitlab.ListUsersOptions{
+			WithCustomAttributes: gitlab.Bool(true),
+		}
 		if usernameOk {
 			// Get user by username
 			listUsersOptions.Username = gitlab.String(username)
@@ -213,6 +220,7 @@ func dataSourceGitlabUserRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("website_url", user.WebsiteURL)
 	d.Set("theme_id", user.ThemeID)
 	d.Set("color_scheme_id", user.ColorSchemeID)
+	d.Set("custom_attributes", flattenCustomAttributes(user.CustomAttributes))
 
 	d.SetId(fmt.Sprintf("%d", user.ID))
 
@@ -55,12 +55,13 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"gitlab_group":            dataSourceGitlabGroup(),
-			"gitlab_group_membership": dataSourceGitlabGroupMembership(),
-			"gitlab_project":          dataSourceGitlabProject(),
-			"gitlab_projects":         dataSourceGitlabProjects(),
-			"gitlab_user":             dataSourceGitlabUser(),
-			"gitlab_users":            dataSourceGitlabUsers(),
+			"gitlab_group":                 dataSourceGitlabGroup(),
+			"gitlab_group_membership":      dataSourceGitlabGroupMembership(),
+			"gitlab_group_saml_identities": dataSourceGitlabGroupSamlIdentities(),
+			"gitlab_project":               dataSourceGitlabProject(),
+			"gitlab_projects":              dataSourceGitlabProjects(),
+			"gitlab_user":                  dataSourceGitlabUser(),
+			"gitlab_users":                 dataSourceGitlabUsers(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -70,6 +71,9 @@ func Provider() terraform.ResourceProvider {
 			"gitlab_project":                    resourceGitlabProject(),
 			"gitlab_label":                      resourceGitlabLabel(),
 			"gitlab_group_label":                resourceGitlabGroupLabel(),
+			"gitlab_project_milestone":          resourceGitlabProjectMilestone(),
+			"gitlab_group_milestone":            resourceGitlabGroupMilestone(),
+			"gitlab_group_iteration_cadence":    resourceGitlabGroupIterationCadence(),
 			"gitlab_pipeline_schedule":          resourceGitlabPipelineSchedule(),
 			"gitlab_pipeline_schedule_variable": resourceGitlabPipelineScheduleVariable(),
 			"gitlab_pipeline_trigger":           resourceGitlabPipelineTrigger(),
@@ -78,10 +82,17 @@ func Provider() terraform.ResourceProvider {
 			"gitlab_deploy_key_enable":          resourceGitlabDeployEnableKey(),
 			"gitlab_deploy_token":               resourceGitlabDeployToken(),
 			"gitlab_user":                       resourceGitlabUser(),
+			"gitlab_user_ssh_key":               resourceGitlabUserSSHKey(),
+			"gitlab_user_gpg_key":               resourceGitlabUserGPGKey(),
+			"gitlab_user_impersonation_token":   resourceGitlabUserImpersonationToken(),
 			"gitlab_project_membership":         resourceGitlabProjectMembership(),
 			"gitlab_group_membership":           resourceGitlabGroupMembership(),
+			"gitlab_project_members":            resourceGitlabProjectMembers(),
+			"gitlab_group_members":              resourceGitlabGroupMembers(),
 			"gitlab_project_variable":           resourceGitlabProjectVariable(),
 			"gitlab_group_variable":             resourceGitlabGroupVariable(),
+			"gitlab_project_variables":          resourceGitlabProjectVariables(),
+			"gitlab_group_variables":            resourceGitlabGroupVariables(),
 			"gitlab_project_cluster":            resourceGitlabProjectCluster(),
 			"gitlab_service_slack":              resourceGitlabServiceSlack(),
 			"gitlab_service_jira":               resourceGitlabServiceJira(),
@@ -90,13 +101,25 @@ func Provider() terraform.ResourceProvider {
 			"gitlab_project_share_group":        resourceGitlabProjectShareGroup(),
 			"gitlab_group_cluster":              resourceGitlabGroupCluster(),
 			"gitlab_group_ldap_link":            resourceGitlabGroupLdapLink(),
+			"gitlab_group_saml_link":            resourceGitlabGroupSamlLink(),
+			"gitlab_group_saml_identity":        resourceGitlabGroupSamlIdentity(),
 			"gitlab_instance_cluster":           resourceGitlabInstanceCluster(),
 			"gitlab_project_mirror":             resourceGitlabProjectMirror(),
 			"gitlab_project_level_mr_approvals": resourceGitlabProjectLevelMRApprovals(),
+			"gitlab_project_push_rules":         resourceGitlabProjectPushRules(),
+			"gitlab_group_push_rules":           resourceGitlabGroupPushRules(),
+			"gitlab_project_badge":              resourceGitlabProjectBadge(),
+			"gitlab_group_badge":                resourceGitlabGroupBadge(),
+			"gitlab_group_level_mr_approvals":   resourceGitlabGroupLevelMRApprovals(),
 			"gitlab_project_approval_rule":      resourceGitlabProjectApprovalRule(),
+			"gitlab_group_approval_rule":        resourceGitlabGroupApprovalRule(),
 			"gitlab_instance_variable":          resourceGitlabInstanceVariable(),
 			"gitlab_project_freeze_period":      resourceGitlabProjectFreezePeriod(),
+			"gitlab_project_environment":        resourceGitlabProjectEnvironment(),
 			"gitlab_group_share_group":          resourceGitlabGroupShareGroup(),
+			"gitlab_project_custom_attribute":   resourceGitlabProjectCustomAttribute(),
+			"gitlab_group_custom_attribute":     resourceGitlabGroupCustomAttribute(),
+			"gitlab_user_custom_attribute":      resourceGitlabUserCustomAttribute(),
 		},
 	}
 
@@ -48,6 +48,10 @@ func resourceGitlabBranchProtection() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"branch_protection_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -119,6 +123,7 @@ func resourceGitlabBranchProtectionRead(d *schema.ResourceData, meta interface{}
 	d.Set("merge_access_level", accessLevel[pb.MergeAccessLevels[0].AccessLevel])
 	d.Set("push_access_level", accessLevel[pb.PushAccessLevels[0].AccessLevel])
 	d.Set("code_owner_approval_required", pb.CodeOwnerApprovalRequired)
+	d.Set("branch_protection_id", pb.ID)
 
 	d.SetId(buildTwoPartID(&project, &pb.Name))
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -20,8 +21,12 @@ func resourceGitlabGroup() *schema.Resource {
 		Update: resourceGitlabGroupUpdate,
 		Delete: resourceGitlabGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceGitlabGroupImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: resourceGitlabGroupCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -112,15 +117,191 @@ func resourceGitlabGroup() *schema.Resource {
 				ForceNew: true,
 				Default:  0,
 			},
+			"default_branch_protection": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1, 2, 3}),
+			},
+			"membership_lock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"shared_runners_setting": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled_with_override", "disabled_and_unoverridable"}, false),
+			},
+			"extra_shared_runners_minutes_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"file_template_project_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"prevent_forking_outside_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ip_restriction_ranges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"allowed_email_domains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 			"runners_token": {
 				Type:      schema.TypeString,
 				Computed:  true,
 				Sensitive: true,
 			},
+			"avatar": {
+				Type:        schema.TypeString,
+				Description: "A local path to the avatar image to upload.",
+				Optional:    true,
+			},
+			"avatar_hash": {
+				Type:        schema.TypeString,
+				Description: "The hash of the avatar image, e.g. filesha256(avatar). Changing it uploads the avatar again.",
+				Optional:    true,
+			},
+			"avatar_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"permanently_remove_on_delete": {
+				Type:        schema.TypeBool,
+				Description: "Whether a group marked for deletion by delayed group deletion should be removed immediately on destroy.",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
 
+// resourceGitlabGroupImport sets the defaults of the arguments that are not read from GitLab.
+func resourceGitlabGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("permanently_remove_on_delete", false)
+	d.Set("avatar", "")
+	d.Set("avatar_hash", "")
+	return []*schema.ResourceData{d}, nil
+}
+
+// groupEnterpriseSettings lists the group settings that only GitLab Enterprise
+// Edition supports, and that the Community Edition silently ignores.
+var groupEnterpriseSettings = []string{
+	"membership_lock",
+	"extra_shared_runners_minutes_limit",
+	"file_template_project_id",
+	"prevent_forking_outside_group",
+	"ip_restriction_ranges",
+	"allowed_email_domains",
+}
+
+func resourceGitlabGroupCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	var attributes []string
+	for _, attribute := range groupEnterpriseSettings {
+		if _, ok := d.GetOk(attribute); ok && d.HasChange(attribute) {
+			attributes = append(attributes, attribute)
+		}
+	}
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	isCommunity, err := isGitLabCommunityEdition(client)()
+	if err != nil {
+		return err
+	}
+	if isCommunity {
+		return fmt.Errorf("%s requires GitLab Enterprise Edition", strings.Join(attributes, ", "))
+	}
+
+	return nil
+}
+
+// groupWithSettings represents a GitLab group including the settings that
+// go-gitlab does not decode.
+type groupWithSettings struct {
+	gitlab.Group
+	DefaultBranchProtection    int    `json:"default_branch_protection"`
+	SharedRunnersSetting       string `json:"shared_runners_setting"`
+	FileTemplateProjectID      int    `json:"file_template_project_id"`
+	PreventForkingOutsideGroup bool   `json:"prevent_forking_outside_group"`
+	IPRestrictionRanges        string `json:"ip_restriction_ranges"`
+	AllowedEmailDomainsList    string `json:"allowed_email_domains_list"`
+}
+
+// editGroupOptions represents the options to update a group including the
+// settings that go-gitlab does not encode.
+type editGroupOptions struct {
+	gitlab.UpdateGroupOptions
+	DefaultBranchProtection    *int    `json:"default_branch_protection,omitempty"`
+	SharedRunnersSetting       *string `json:"shared_runners_setting,omitempty"`
+	FileTemplateProjectID      *int    `json:"file_template_project_id,omitempty"`
+	PreventForkingOutsideGroup *bool   `json:"prevent_forking_outside_group,omitempty"`
+	IPRestrictionRanges        *string `json:"ip_restriction_ranges,omitempty"`
+	AllowedEmailDomainsList    *string `json:"allowed_email_domains_list,omitempty"`
+}
+
+func getGroupWithSettings(client *gitlab.Client, group string) (*groupWithSettings, *gitlab.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s", pathEscape(group)), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g := new(groupWithSettings)
+	resp, err := client.Do(req, g)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return g, resp, nil
+}
+
+func editGroup(client *gitlab.Client, group string, options *editGroupOptions) error {
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("groups/%s", pathEscape(group)), options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+// expandCommaSeparatedSet joins a set of strings into the comma-separated list
+// GitLab expects for some settings.
+func expandCommaSeparatedSet(set *schema.Set) *string {
+	values := *stringSetToStringSlice(set)
+	sort.Strings(values)
+	return gitlab.String(strings.Join(values, ","))
+}
+
+// flattenCommaSeparatedList splits a comma-separated list of a setting.
+func flattenCommaSeparatedList(list string) []string {
+	values := []string{}
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 func resourceGitlabGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 	options := &gitlab.CreateGroupOptions{
@@ -177,15 +358,67 @@ func resourceGitlabGroupCreate(d *schema.ResourceData, meta interface{}) error {
 		options.ParentID = gitlab.Int(v.(int))
 	}
 
+	if v, ok := d.GetOk("membership_lock"); ok {
+		options.MembershipLock = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("extra_shared_runners_minutes_limit"); ok {
+		options.ExtraSharedRunnersMinutesLimit = gitlab.Int(v.(int))
+	}
+
 	log.Printf("[DEBUG] create gitlab group %q", *options.Name)
 
 	group, _, err := client.Groups.CreateGroup(options)
 	if err != nil {
+		if pendingErr := checkGroupPathPendingDeletion(client, d); pendingErr != nil {
+			return pendingErr
+		}
 		return err
 	}
 
 	d.SetId(fmt.Sprintf("%d", group.ID))
 
+	// Some group settings can't be set in the Group Create API and have to be
+	// set in a second call after group creation.
+	settings := &editGroupOptions{}
+
+	if v, ok := d.GetOkExists("default_branch_protection"); ok {
+		settings.DefaultBranchProtection = gitlab.Int(v.(int))
+	}
+
+	if v, ok := d.GetOk("shared_runners_setting"); ok {
+		settings.SharedRunnersSetting = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("file_template_project_id"); ok {
+		settings.FileTemplateProjectID = gitlab.Int(v.(int))
+	}
+
+	if v, ok := d.GetOk("prevent_forking_outside_group"); ok {
+		settings.PreventForkingOutsideGroup = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("ip_restriction_ranges"); ok {
+		settings.IPRestrictionRanges = expandCommaSeparatedSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_email_domains"); ok {
+		settings.AllowedEmailDomainsList = expandCommaSeparatedSet(v.(*schema.Set))
+	}
+
+	if *settings != (editGroupOptions{}) {
+		log.Printf("[DEBUG] update gitlab group %s settings", d.Id())
+		if err := editGroup(client, d.Id(), settings); err != nil {
+			return fmt.Errorf("new group %q settings could not be updated: %w", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("avatar"); ok {
+		if err := updateAvatar(client, fmt.Sprintf("groups/%d", group.ID), v.(string)); err != nil {
+			return fmt.Errorf("new group %q avatar could not be uploaded: %w", d.Id(), err)
+		}
+	}
+
 	return resourceGitlabGroupRead(d, meta)
 }
 
@@ -193,7 +426,7 @@ func resourceGitlabGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 	log.Printf("[DEBUG] read gitlab group %s", d.Id())
 
-	group, resp, err := client.Groups.GetGroup(d.Id())
+	group, resp, err := getGroupWithSettings(client, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			log.Printf("[DEBUG] gitlab group %s not found so removing from state", d.Id())
@@ -228,6 +461,15 @@ func resourceGitlabGroupRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("parent_id", group.ParentID)
 	d.Set("runners_token", group.RunnersToken)
 	d.Set("share_with_group_lock", group.ShareWithGroupLock)
+	d.Set("avatar_url", group.AvatarURL)
+	d.Set("default_branch_protection", group.DefaultBranchProtection)
+	d.Set("membership_lock", group.MembershipLock)
+	d.Set("shared_runners_setting", group.SharedRunnersSetting)
+	d.Set("extra_shared_runners_minutes_limit", group.ExtraSharedRunnersMinutesLimit)
+	d.Set("file_template_project_id", group.FileTemplateProjectID)
+	d.Set("prevent_forking_outside_group", group.PreventForkingOutsideGroup)
+	d.Set("ip_restriction_ranges", flattenCommaSeparatedList(group.IPRestrictionRanges))
+	d.Set("allowed_email_domains", flattenCommaSeparatedList(group.AllowedEmailDomainsList))
 
 	return nil
 }
@@ -235,7 +477,7 @@ func resourceGitlabGroupRead(d *schema.ResourceData, meta interface{}) error {
 func resourceGitlabGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 
-	options := &gitlab.UpdateGroupOptions{}
+	options := &editGroupOptions{}
 
 	if d.HasChange("name") {
 		options.Name = gitlab.String(d.Get("name").(string))
@@ -295,13 +537,51 @@ func resourceGitlabGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		options.ShareWithGroupLock = gitlab.Bool(d.Get("share_with_group_lock").(bool))
 	}
 
+	if d.HasChange("default_branch_protection") {
+		options.DefaultBranchProtection = gitlab.Int(d.Get("default_branch_protection").(int))
+	}
+
+	if d.HasChange("membership_lock") {
+		options.MembershipLock = gitlab.Bool(d.Get("membership_lock").(bool))
+	}
+
+	if d.HasChange("shared_runners_setting") {
+		options.SharedRunnersSetting = gitlab.String(d.Get("shared_runners_setting").(string))
+	}
+
+	if d.HasChange("extra_shared_runners_minutes_limit") {
+		options.ExtraSharedRunnersMinutesLimit = gitlab.Int(d.Get("extra_shared_runners_minutes_limit").(int))
+	}
+
+	if d.HasChange("file_template_project_id") {
+		options.FileTemplateProjectID = gitlab.Int(d.Get("file_template_project_id").(int))
+	}
+
+	if d.HasChange("prevent_forking_outside_group") {
+		options.PreventForkingOutsideGroup = gitlab.Bool(d.Get("prevent_forking_outside_group").(bool))
+	}
+
+	if d.HasChange("ip_restriction_ranges") {
+		options.IPRestrictionRanges = expandCommaSeparatedSet(d.Get("ip_restriction_ranges").(*schema.Set))
+	}
+
+	if d.HasChange("allowed_email_domains") {
+		options.AllowedEmailDomainsList = expandCommaSeparatedSet(d.Get("allowed_email_domains").(*schema.Set))
+	}
+
 	log.Printf("[DEBUG] update gitlab group %s", d.Id())
 
-	_, _, err := client.Groups.UpdateGroup(d.Id(), options)
-	if err != nil {
+	if err := editGroup(client, d.Id(), options); err != nil {
 		return err
 	}
 
+	if d.HasChanges("avatar", "avatar_hash") {
+		log.Printf("[DEBUG] update gitlab group %s avatar", d.Id())
+		if err := updateAvatar(client, fmt.Sprintf("groups/%s", pathEscape(d.Id())), d.Get("avatar").(string)); err != nil {
+			return fmt.Errorf("group %q avatar could not be updated: %w", d.Id(), err)
+		}
+	}
+
 	return resourceGitlabGroupRead(d, meta)
 }
 
@@ -314,6 +594,9 @@ func resourceGitlabGroupDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error deleting group %s: %s", d.Id(), err)
 	}
 
+	permanentlyRemove := d.Get("permanently_remove_on_delete").(bool)
+	permanentlyRemoveRequested := false
+
 	// Wait for the group to be deleted.
 	// Deleting a group in gitlab is async.
 	stateConf := &resource.StateChangeConf{
@@ -330,12 +613,20 @@ func resourceGitlabGroupDelete(d *schema.ResourceData, meta interface{}) error {
 			}
 			if out.MarkedForDeletionOn != nil {
 				// Represents a Gitlab EE soft-delete
-				return out, "Deleted", nil
+				if !permanentlyRemove {
+					return out, "Deleted", nil
+				}
+				if !permanentlyRemoveRequested {
+					if err := permanentlyRemoveGroup(client, out); err != nil {
+						return out, "Error", err
+					}
+					permanentlyRemoveRequested = true
+				}
 			}
 			return out, "Deleting", nil
 		},
 
-		Timeout:    10 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 3 * time.Second,
 		Delay:      5 * time.Second,
 	}
@@ -346,3 +637,45 @@ func resourceGitlabGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 	return err
 }
+
+// permanentlyRemoveGroup removes a group that is already marked for deletion
+// without waiting for the delayed group deletion period to expire.
+func permanentlyRemoveGroup(client *gitlab.Client, group *gitlab.Group) error {
+	isSupported, err := isGitLabVersionAtLeast(client, "15.4")()
+	if err != nil {
+		return err
+	}
+	if !isSupported {
+		return fmt.Errorf("group %q is marked for deletion and permanently removing it requires GitLab 15.4 or later", group.FullPath)
+	}
+
+	log.Printf("[DEBUG] permanently remove gitlab group %q", group.FullPath)
+
+	_, err = client.Groups.DeleteGroup(group.ID, withPermanentRemoval(group.FullPath))
+	if err != nil {
+		return fmt.Errorf("group %q could not be permanently removed, check that the instance allows immediate deletion: %w", group.FullPath, err)
+	}
+	return nil
+}
+
+// checkGroupPathPendingDeletion returns an error if the path of the group to create
+// is still held by a group that is marked for deletion.
+func checkGroupPathPendingDeletion(client *gitlab.Client, d *schema.ResourceData) error {
+	fullPath := d.Get("path").(string)
+	if v, ok := d.GetOk("parent_id"); ok {
+		parent, _, err := client.Groups.GetGroup(v.(int))
+		if err != nil {
+			return nil
+		}
+		fullPath = fmt.Sprintf("%s/%s", parent.FullPath, fullPath)
+	}
+
+	group, _, err := client.Groups.GetGroup(fullPath)
+	if err != nil || group.MarkedForDeletionOn == nil {
+		return nil
+	}
+
+	return fmt.Errorf("group path %q is still held by group %d, which is pending deletion since %s; "+
+		"wait for the deletion to complete or destroy it with permanently_remove_on_delete enabled",
+		fullPath, group.ID, group.MarkedForDeletionOn)
+}
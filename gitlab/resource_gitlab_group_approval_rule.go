@@ -0,0 +1,182 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#group-approval-rules
+func resourceGitlabGroupApprovalRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupApprovalRuleCreate,
+		Read:   resourceGitlabGroupApprovalRuleRead,
+		Update: resourceGitlabGroupApprovalRuleUpdate,
+		Delete: resourceGitlabGroupApprovalRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"approvals_required": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"rule_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "regular",
+				ValidateFunc: validation.StringInSlice(approvalRuleTypes, false),
+			},
+			"user_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+			"group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+		},
+	}
+}
+
+func resourceGitlabGroupApprovalRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	options := expandApprovalRuleOptions(d)
+	options.RuleType = gitlab.String(d.Get("rule_type").(string))
+
+	group := d.Get("group").(string)
+
+	log.Printf("[DEBUG] Group %s create gitlab group-level rule %+v", group, options)
+
+	client := meta.(*gitlab.Client)
+
+	rule, err := createApprovalRule(client, groupApprovalRulesPath(group), options)
+	if err != nil {
+		return augmentGroupApprovalRuleClientError(err)
+	}
+
+	ruleIDString := strconv.Itoa(rule.ID)
+
+	d.SetId(buildTwoPartID(&group, &ruleIDString))
+
+	return resourceGitlabGroupApprovalRuleRead(d, meta)
+}
+
+func resourceGitlabGroupApprovalRuleRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] read gitlab group-level rule %s", d.Id())
+
+	group, ruleID, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("group", group)
+
+	ruleIDInt, err := strconv.Atoi(ruleID)
+	if err != nil {
+		return err
+	}
+
+	rule, err := findApprovalRule(meta.(*gitlab.Client), groupApprovalRulesPath(group), ruleIDInt)
+	if err != nil {
+		var httpErr *gitlab.ErrorResponse
+		if errors.Is(err, errApprovalRuleNotFound) || (errors.As(err, &httpErr) && httpErr.Response.StatusCode == http.StatusNotFound) {
+			log.Printf("[DEBUG] gitlab group-level rule %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	return setApprovalRuleToState(d, rule)
+}
+
+func resourceGitlabGroupApprovalRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	group, ruleID, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ruleIDInt, err := strconv.Atoi(ruleID)
+	if err != nil {
+		return err
+	}
+
+	options := expandApprovalRuleOptions(d)
+
+	log.Printf("[DEBUG] Group %s update gitlab group-level approval rule %s", group, *options.Name)
+
+	client := meta.(*gitlab.Client)
+
+	_, err = updateApprovalRule(client, groupApprovalRulesPath(group), ruleIDInt, options)
+	if err != nil {
+		return augmentGroupApprovalRuleClientError(err)
+	}
+
+	return resourceGitlabGroupApprovalRuleRead(d, meta)
+}
+
+func resourceGitlabGroupApprovalRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	group, ruleID, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ruleIDInt, err := strconv.Atoi(ruleID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Group %s delete gitlab group-level approval rule %d", group, ruleIDInt)
+
+	client := meta.(*gitlab.Client)
+
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%d", groupApprovalRulesPath(group), ruleIDInt), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab group-level approval rule %s already deleted", d.Id())
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func groupApprovalRulesPath(group string) string {
+	return fmt.Sprintf("groups/%s/approval_rules", pathEscape(group))
+}
+
+func augmentGroupApprovalRuleClientError(err error) error {
+	// Group approval rules only exist in recent GitLab versions, older ones answer with a 404.
+	var httpErr *gitlab.ErrorResponse
+	if errors.As(err, &httpErr) && httpErr.Response.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Failed to manage group approval rule: %v", err)
+		return errors.New("Group approval rules are not supported in your version of GitLab")
+	}
+
+	return err
+}
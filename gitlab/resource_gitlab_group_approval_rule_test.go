@@ -0,0 +1,122 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitLabGroupApprovalRule_basic(t *testing.T) {
+	var rule approvalRule
+	randomInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			{ // Create Rule
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitLabGroupApprovalRuleConfig(randomInt, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupApprovalRuleExists("gitlab_group_approval_rule.foo", &rule),
+					testAccCheckGitlabGroupApprovalRuleApprovalsRequired(&rule, 2),
+					resource.TestCheckResourceAttr("gitlab_group_approval_rule.foo", "rule_type", "regular"),
+					resource.TestCheckResourceAttr("gitlab_group_approval_rule.foo", "user_ids.#", "1"),
+				),
+			},
+			{ // Update Rule
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitLabGroupApprovalRuleConfig(randomInt, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupApprovalRuleExists("gitlab_group_approval_rule.foo", &rule),
+					testAccCheckGitlabGroupApprovalRuleApprovalsRequired(&rule, 1),
+				),
+			},
+			{ // Verify Import
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_group_approval_rule.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupApprovalRuleExists(n string, rule *approvalRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		groupID, ruleID, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ruleIDInt, err := strconv.Atoi(ruleID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*gitlab.Client)
+
+		gotRule, err := findApprovalRule(client, groupApprovalRulesPath(groupID), ruleIDInt)
+		if err != nil {
+			return err
+		}
+
+		*rule = *gotRule
+		return nil
+	}
+}
+
+func testAccCheckGitlabGroupApprovalRuleApprovalsRequired(rule *approvalRule, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if rule.ApprovalsRequired != want {
+			return fmt.Errorf("got approvals_required %d; want %d", rule.ApprovalsRequired, want)
+		}
+		return nil
+	}
+}
+
+func testAccGitLabGroupApprovalRuleConfig(randomInt int, approvals int) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name             = "foo user"
+  username         = "foo-user-%[1]d"
+  password         = "foo12345"
+  email            = "foo-user%[1]d@ssss.com"
+  is_admin         = false
+  projects_limit   = 2
+  can_create_group = false
+  is_external      = false
+}
+
+resource "gitlab_group" "foo" {
+  name             = "foo-group %[1]d"
+  path             = "foo-group-%[1]d"
+  description      = "Terraform acceptance tests - Group Approval Rule"
+  visibility_level = "public"
+}
+
+resource "gitlab_group_membership" "foo" {
+  group_id     = gitlab_group.foo.id
+  user_id      = gitlab_user.foo.id
+  access_level = "developer"
+}
+
+resource "gitlab_group_approval_rule" "foo" {
+  group              = gitlab_group.foo.id
+  name               = "foo rule %[1]d"
+  approvals_required = %[2]d
+  user_ids           = [gitlab_group_membership.foo.user_id]
+}
+	`, randomInt, approvals)
+}
@@ -0,0 +1,99 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupBadge() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupBadgeCreate,
+		Read:   resourceGitlabGroupBadgeRead,
+		Update: resourceGitlabGroupBadgeUpdate,
+		Delete: resourceGitlabGroupBadgeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: badgeSchema("group"),
+	}
+}
+
+func resourceGitlabGroupBadgeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	groupID := d.Get("group").(string)
+
+	log.Printf("[DEBUG] create gitlab group %s badge %q", groupID, d.Get("link_url").(string))
+
+	badge, err := addBadge(client, groupBadgesPath(groupID), expandBadgeOptions(d))
+	if err != nil {
+		return err
+	}
+
+	badgeID := strconv.Itoa(badge.ID)
+	d.SetId(buildTwoPartID(&groupID, &badgeID))
+
+	return resourceGitlabGroupBadgeRead(d, meta)
+}
+
+func resourceGitlabGroupBadgeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	groupID, badgeID, err := parseTwoPartIntID(d.Id(), "badge")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab group %s badge %d", groupID, badgeID)
+
+	badge, resp, err := getBadge(client, groupBadgesPath(groupID), badgeID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab group badge %s not found so removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group", groupID)
+	setBadgeToState(d, badge)
+
+	return nil
+}
+
+func resourceGitlabGroupBadgeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	groupID, badgeID, err := parseTwoPartIntID(d.Id(), "badge")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] update gitlab group %s badge %d", groupID, badgeID)
+
+	if _, err := editBadge(client, groupBadgesPath(groupID), badgeID, expandBadgeOptions(d)); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupBadgeRead(d, meta)
+}
+
+func resourceGitlabGroupBadgeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	groupID, badgeID, err := parseTwoPartIntID(d.Id(), "badge")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab group %s badge %d", groupID, badgeID)
+
+	_, err = client.GroupBadges.DeleteGroupBadge(groupID, badgeID)
+	return err
+}
+
+func groupBadgesPath(groupID string) string {
+	return fmt.Sprintf("groups/%s/badges", pathEscape(groupID))
+}
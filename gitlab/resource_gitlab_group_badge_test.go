@@ -0,0 +1,92 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupBadge_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupBadgeDestroy,
+		Steps: []resource.TestStep{
+			// Create a group badge with placeholders, which are rendered in the projects of the group
+			{
+				Config: testAccGitlabGroupBadgeConfig(rInt, "pipeline", "https://example.com/%%{project_path}", "https://example.com/%%{project_path}/badges/%%{default_branch}/pipeline.svg"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "name", "pipeline"),
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "link_url", "https://example.com/%{project_path}"),
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "image_url", "https://example.com/%{project_path}/badges/%{default_branch}/pipeline.svg"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_badge.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the group badge
+			{
+				Config: testAccGitlabGroupBadgeConfig(rInt, "coverage", "https://example.com/coverage", "https://example.com/coverage.svg"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "name", "coverage"),
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "rendered_link_url", "https://example.com/coverage"),
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "rendered_image_url", "https://example.com/coverage.svg"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupBadgeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_badge" {
+			continue
+		}
+
+		groupID, badgeID, err := parseTwoPartIntID(rs.Primary.ID, "badge")
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := getBadge(client, groupBadgesPath(groupID), badgeID)
+		if err == nil {
+			return fmt.Errorf("group badge %s still exists", rs.Primary.ID)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccGitlabGroupBadgeConfig(rInt int, name, linkURL, imageURL string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%[1]d"
+  path = "foo-path-%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_group_badge" "foo" {
+  group   = gitlab_group.foo.id
+  name      = "%[2]s"
+  link_url  = "%[3]s"
+  image_url = "%[4]s"
+}
+	`, rInt, name, linkURL, imageURL)
+}
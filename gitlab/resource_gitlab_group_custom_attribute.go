@@ -0,0 +1,70 @@
+package gitlab
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupCustomAttribute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupCustomAttributeCreate,
+		Read:   resourceGitlabGroupCustomAttributeRead,
+		Update: resourceGitlabGroupCustomAttributeUpdate,
+		Delete: resourceGitlabGroupCustomAttributeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: customAttributeSchema("group_id"),
+	}
+}
+
+func groupCustomAttributesAPI(client *gitlab.Client) customAttributesAPI {
+	return customAttributesAPI{
+		get:    client.CustomAttribute.GetCustomGroupAttribute,
+		set:    client.CustomAttribute.SetCustomGroupAttribute,
+		delete: client.CustomAttribute.DeleteCustomGroupAttribute,
+	}
+}
+
+func resourceGitlabGroupCustomAttributeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group_id").(int)
+	key := d.Get("key").(string)
+
+	log.Printf("[DEBUG] create gitlab group %d custom attribute %q", group, key)
+
+	if err := setCustomAttribute(groupCustomAttributesAPI(client), group, d); err != nil {
+		return err
+	}
+
+	d.SetId(buildCustomAttributeID(group, key))
+
+	return resourceGitlabGroupCustomAttributeRead(d, meta)
+}
+
+func resourceGitlabGroupCustomAttributeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	return readCustomAttribute(groupCustomAttributesAPI(client), "group_id", d)
+}
+
+func resourceGitlabGroupCustomAttributeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group_id").(int)
+
+	log.Printf("[DEBUG] update gitlab group %d custom attribute %q", group, d.Get("key").(string))
+
+	if err := setCustomAttribute(groupCustomAttributesAPI(client), group, d); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupCustomAttributeRead(d, meta)
+}
+
+func resourceGitlabGroupCustomAttributeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	return deleteCustomAttribute(groupCustomAttributesAPI(client), d)
+}
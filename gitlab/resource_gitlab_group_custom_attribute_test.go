@@ -0,0 +1,63 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGitlabGroupCustomAttribute_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabCustomAttributeDestroy("gitlab_group_custom_attribute", groupCustomAttributesAPI),
+		Steps: []resource.TestStep{
+			// Create a custom attribute and read it with the group data source
+			{
+				Config: testAccGitlabGroupCustomAttributeConfig(rInt, "finance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_custom_attribute.foo", "key", "cost_center"),
+					resource.TestCheckResourceAttr("gitlab_group_custom_attribute.foo", "value", "finance"),
+					resource.TestCheckResourceAttr("data.gitlab_group.foo", "custom_attributes.cost_center", "finance"),
+				),
+			},
+			// Update the value
+			{
+				Config: testAccGitlabGroupCustomAttributeConfig(rInt, "engineering"),
+				Check:  resource.TestCheckResourceAttr("gitlab_group_custom_attribute.foo", "value", "engineering"),
+			},
+			{
+				ResourceName:      "gitlab_group_custom_attribute.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGitlabGroupCustomAttributeConfig(rInt int, value string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%[1]d"
+  path = "foo-path-%[1]d"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_group_custom_attribute" "foo" {
+  group_id = gitlab_group.foo.id
+  key      = "cost_center"
+  value    = "%[2]s"
+}
+
+data "gitlab_group" "foo" {
+  group_id = gitlab_group_custom_attribute.foo.group_id
+}
+`, rInt, value)
+}
@@ -0,0 +1,331 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupIterationCadence() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupIterationCadenceCreate,
+		Read:   resourceGitlabGroupIterationCadenceRead,
+		Update: resourceGitlabGroupIterationCadenceUpdate,
+		Delete: resourceGitlabGroupIterationCadenceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"automatic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDateFunc,
+			},
+			"duration_in_weeks": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"iterations_in_advance": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"roll_over": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Iteration cadences are only available in the GraphQL API.
+
+type iterationCadence struct {
+	ID                  string `json:"id"`
+	Title               string `json:"title"`
+	Description         string `json:"description"`
+	Automatic           bool   `json:"automatic"`
+	Active              bool   `json:"active"`
+	StartDate           string `json:"startDate"`
+	DurationInWeeks     int    `json:"durationInWeeks"`
+	IterationsInAdvance int    `json:"iterationsInAdvance"`
+	RollOver            bool   `json:"rollOver"`
+}
+
+const iterationCadenceFields = `id title description automatic active startDate durationInWeeks iterationsInAdvance rollOver`
+
+const iterationCadenceGlobalIDPrefix = "gid://gitlab/Iterations::Cadence/"
+
+func resourceGitlabGroupIterationCadenceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	g, _, err := client.Groups.GetGroup(group)
+	if err != nil {
+		return err
+	}
+
+	input := expandIterationCadenceInput(d)
+	input["groupPath"] = g.FullPath
+
+	log.Printf("[DEBUG] create gitlab group %s iteration cadence %q", group, d.Get("title").(string))
+
+	var data struct {
+		IterationCadenceCreate struct {
+			IterationCadence iterationCadence `json:"iterationCadence"`
+			Errors           []string         `json:"errors"`
+		} `json:"iterationCadenceCreate"`
+	}
+	query := `mutation($input: IterationCadenceCreateInput!) {
+  iterationCadenceCreate(input: $input) { iterationCadence { ` + iterationCadenceFields + ` } errors }
+}`
+	if err := graphQLRequest(client, query, map[string]interface{}{"input": input}, &data); err != nil {
+		return augmentIterationCadenceClientError(err)
+	}
+	if errs := data.IterationCadenceCreate.Errors; len(errs) > 0 {
+		return fmt.Errorf("failed to create iteration cadence: %s", strings.Join(errs, ", "))
+	}
+
+	cadenceID := strings.TrimPrefix(data.IterationCadenceCreate.IterationCadence.ID, iterationCadenceGlobalIDPrefix)
+	d.SetId(buildTwoPartID(&group, &cadenceID))
+
+	return resourceGitlabGroupIterationCadenceRead(d, meta)
+}
+
+func resourceGitlabGroupIterationCadenceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, cadenceID, err := parseTwoPartIntID(d.Id(), "iteration cadence")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab group %s iteration cadence %d", group, cadenceID)
+
+	g, resp, err := client.Groups.GetGroup(group)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab group %s not found so removing iteration cadence from state", group)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var data struct {
+		Group struct {
+			IterationCadences struct {
+				Nodes []iterationCadence `json:"nodes"`
+			} `json:"iterationCadences"`
+		} `json:"group"`
+	}
+	query := `query($fullPath: ID!, $id: IterationsCadenceID!) {
+  group(fullPath: $fullPath) { iterationCadences(id: $id) { nodes { ` + iterationCadenceFields + ` } } }
+}`
+	variables := map[string]interface{}{
+		"fullPath": g.FullPath,
+		"id":       fmt.Sprintf("%s%d", iterationCadenceGlobalIDPrefix, cadenceID),
+	}
+	if err := graphQLRequest(client, query, variables, &data); err != nil {
+		return augmentIterationCadenceClientError(err)
+	}
+
+	nodes := data.Group.IterationCadences.Nodes
+	if len(nodes) == 0 {
+		log.Printf("[DEBUG] gitlab group iteration cadence %s not found so removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	cadence := nodes[0]
+
+	d.Set("group", group)
+	d.Set("title", cadence.Title)
+	d.Set("description", cadence.Description)
+	d.Set("automatic", cadence.Automatic)
+	d.Set("active", cadence.Active)
+	d.Set("start_date", cadence.StartDate)
+	d.Set("duration_in_weeks", cadence.DurationInWeeks)
+	d.Set("iterations_in_advance", cadence.IterationsInAdvance)
+	d.Set("roll_over", cadence.RollOver)
+
+	return nil
+}
+
+func resourceGitlabGroupIterationCadenceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, cadenceID, err := parseTwoPartIntID(d.Id(), "iteration cadence")
+	if err != nil {
+		return err
+	}
+
+	input := expandIterationCadenceInput(d)
+	input["id"] = fmt.Sprintf("%s%d", iterationCadenceGlobalIDPrefix, cadenceID)
+
+	log.Printf("[DEBUG] update gitlab group %s iteration cadence %d", group, cadenceID)
+
+	var data struct {
+		IterationCadenceUpdate struct {
+			Errors []string `json:"errors"`
+		} `json:"iterationCadenceUpdate"`
+	}
+	query := `mutation($input: IterationCadenceUpdateInput!) {
+  iterationCadenceUpdate(input: $input) { errors }
+}`
+	if err := graphQLRequest(client, query, map[string]interface{}{"input": input}, &data); err != nil {
+		return augmentIterationCadenceClientError(err)
+	}
+	if errs := data.IterationCadenceUpdate.Errors; len(errs) > 0 {
+		return fmt.Errorf("failed to update iteration cadence %q: %s", d.Id(), strings.Join(errs, ", "))
+	}
+
+	return resourceGitlabGroupIterationCadenceRead(d, meta)
+}
+
+func resourceGitlabGroupIterationCadenceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, cadenceID, err := parseTwoPartIntID(d.Id(), "iteration cadence")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab group %s iteration cadence %d", group, cadenceID)
+
+	var data struct {
+		IterationCadenceDestroy struct {
+			Errors []string `json:"errors"`
+		} `json:"iterationCadenceDestroy"`
+	}
+	query := `mutation($id: IterationsCadenceID!) {
+  iterationCadenceDestroy(input: { id: $id }) { errors }
+}`
+	variables := map[string]interface{}{
+		"id": fmt.Sprintf("%s%d", iterationCadenceGlobalIDPrefix, cadenceID),
+	}
+	if err := graphQLRequest(client, query, variables, &data); err != nil {
+		return augmentIterationCadenceClientError(err)
+	}
+	if errs := data.IterationCadenceDestroy.Errors; len(errs) > 0 {
+		return fmt.Errorf("failed to delete iteration cadence %q: %s", d.Id(), strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+func expandIterationCadenceInput(d *schema.ResourceData) map[string]interface{} {
+	input := map[string]interface{}{
+		"title":       d.Get("title").(string),
+		"description": d.Get("description").(string),
+		"automatic":   d.Get("automatic").(bool),
+		"active":      d.Get("active").(bool),
+		"rollOver":    d.Get("roll_over").(bool),
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		input["startDate"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("duration_in_weeks"); ok {
+		input["durationInWeeks"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("iterations_in_advance"); ok {
+		input["iterationsInAdvance"] = v.(int)
+	}
+
+	return input
+}
+
+func augmentIterationCadenceClientError(err error) error {
+	var gqlErr *graphQLError
+	if errors.As(err, &gqlErr) && strings.Contains(gqlErr.Error(), "doesn't exist on type") {
+		log.Printf("[DEBUG] Failed iteration cadence request: %v", err)
+		return errors.New("Iteration cadences are not supported in your version of GitLab")
+	}
+
+	return err
+}
+
+type graphQLError struct {
+	Messages []string
+}
+
+func (e *graphQLError) Error() string {
+	return fmt.Sprintf("GraphQL request failed: %s", strings.Join(e.Messages, ", "))
+}
+
+// graphQLRequest sends query with the given variables to the GraphQL API of the
+// GitLab instance and decodes the returned data into v.
+func graphQLRequest(client *gitlab.Client, query string, variables map[string]interface{}, v interface{}) error {
+	body := map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	}
+
+	req, err := client.NewRequest(http.MethodPost, "", body, []gitlab.RequestOptionFunc{withGraphQLEndpoint})
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	if _, err := client.Do(req, &resp); err != nil {
+		return err
+	}
+
+	if len(resp.Errors) > 0 {
+		gqlErr := &graphQLError{}
+		for _, e := range resp.Errors {
+			gqlErr.Messages = append(gqlErr.Messages, e.Message)
+		}
+		return gqlErr
+	}
+
+	return json.Unmarshal(resp.Data, v)
+}
+
+// withGraphQLEndpoint sends the request to /api/graphql instead of the REST API,
+// which is the base URL of the client.
+func withGraphQLEndpoint(req *retryablehttp.Request) error {
+	req.URL.Path = strings.TrimSuffix(strings.TrimSuffix(req.URL.Path, "/"), "/v4") + "/graphql"
+	req.URL.RawPath = ""
+	return nil
+}
@@ -0,0 +1,92 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGitlabGroupIterationCadence_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			// Create an automatic iteration cadence
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabGroupIterationCadenceConfig(rInt, 2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_iteration_cadence.foo", "title", fmt.Sprintf("Sprints %d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_group_iteration_cadence.foo", "automatic", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_iteration_cadence.foo", "start_date", "2030-01-07"),
+					resource.TestCheckResourceAttr("gitlab_group_iteration_cadence.foo", "duration_in_weeks", "2"),
+					resource.TestCheckResourceAttr("gitlab_group_iteration_cadence.foo", "iterations_in_advance", "3"),
+					resource.TestCheckResourceAttr("gitlab_group_iteration_cadence.foo", "roll_over", "false"),
+				),
+			},
+			{
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_group_iteration_cadence.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the iteration cadence
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabGroupIterationCadenceConfig(rInt, 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_iteration_cadence.foo", "duration_in_weeks", "1"),
+					resource.TestCheckResourceAttr("gitlab_group_iteration_cadence.foo", "roll_over", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestWithGraphQLEndpoint(t *testing.T) {
+	for _, baseURL := range []string{"https://gitlab.example.com/api/v4/", "https://example.com/gitlab/api/v4/"} {
+		req, err := retryablehttp.NewRequest(http.MethodPost, baseURL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := withGraphQLEndpoint(req); err != nil {
+			t.Fatal(err)
+		}
+
+		want := baseURL[:len(baseURL)-len("v4/")] + "graphql"
+		if got := req.URL.String(); got != want {
+			t.Errorf("got URL %q; want %q", got, want)
+		}
+	}
+}
+
+func testAccGitlabGroupIterationCadenceConfig(rInt int, durationInWeeks int, rollOver bool) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name        = "foo-name-%[1]d"
+  path        = "foo-path-%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_group_iteration_cadence" "foo" {
+  group                 = gitlab_group.foo.id
+  title                 = "Sprints %[1]d"
+  start_date            = "2030-01-07"
+  duration_in_weeks     = %[2]d
+  iterations_in_advance = 3
+  roll_over             = %[3]t
+}
+	`, rInt, durationInWeeks, rollOver)
+}
@@ -31,8 +31,10 @@ func resourceGitlabGroupLabel() *schema.Resource {
 				ForceNew: true,
 			},
 			"color": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateLabelColorFunc,
+				DiffSuppressFunc: suppressEquivalentLabelColor,
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -19,6 +20,9 @@ func resourceGitlabGroupLdapLink() *schema.Resource {
 		Create: resourceGitlabGroupLdapLinkCreate,
 		Read:   resourceGitlabGroupLdapLinkRead,
 		Delete: resourceGitlabGroupLdapLinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGitlabGroupLdapLinkImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -27,9 +31,16 @@ func resourceGitlabGroupLdapLink() *schema.Resource {
 				ForceNew: true,
 			},
 			"cn": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cn", "filter"},
+			},
+			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cn", "filter"},
 			},
 			// Using the friendlier "access_level" here instead of the GitLab API "group_access".
 			"access_level": {
@@ -54,32 +65,56 @@ func resourceGitlabGroupLdapLink() *schema.Resource {
 	}
 }
 
+// ldapGroupLink is an LDAP group link of a group. go-gitlab doesn't know about
+// filter-based links yet, so they are read and written with raw requests.
+type ldapGroupLink struct {
+	gitlab.LDAPGroupLink
+	Filter string `json:"filter"`
+}
+
+// ldapGroupLinkOptions identifies an LDAP group link by either its CN or its
+// filter when adding or deleting it.
+type ldapGroupLinkOptions struct {
+	CN          *string `url:"cn,omitempty" json:"cn,omitempty"`
+	Filter      *string `url:"filter,omitempty" json:"filter,omitempty"`
+	GroupAccess *int    `url:"group_access,omitempty" json:"group_access,omitempty"`
+	Provider    *string `url:"provider,omitempty" json:"provider,omitempty"`
+}
+
 func resourceGitlabGroupLdapLinkCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 
 	groupId := d.Get("group_id").(string)
-	cn := d.Get("cn").(string)
 	group_access := int(accessLevelNameToValue[d.Get("access_level").(string)])
 	ldap_provider := d.Get("ldap_provider").(string)
 	force := d.Get("force").(bool)
 
-	options := &gitlab.AddGroupLDAPLinkOptions{
-		CN:          &cn,
+	options := &ldapGroupLinkOptions{
 		GroupAccess: &group_access,
 		Provider:    &ldap_provider,
 	}
+	if cn, ok := d.GetOk("cn"); ok {
+		options.CN = gitlab.String(cn.(string))
+	} else {
+		options.Filter = gitlab.String(d.Get("filter").(string))
+	}
 
 	if force {
 		resourceGitlabGroupLdapLinkDelete(d, meta)
 	}
 
 	log.Printf("[DEBUG] Create GitLab group LdapLink %s", d.Id())
-	LdapLink, _, err := client.Groups.AddGroupLDAPLink(groupId, options)
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/ldap_group_links", pathEscape(groupId)), options, nil)
 	if err != nil {
 		return err
 	}
 
-	d.SetId(buildTwoPartID(&LdapLink.Provider, &LdapLink.CN))
+	LdapLink := new(ldapGroupLink)
+	if _, err := client.Do(req, LdapLink); err != nil {
+		return err
+	}
+
+	d.SetId(ldapGroupLinkID(LdapLink))
 
 	return resourceGitlabGroupLdapLinkRead(d, meta)
 }
@@ -90,18 +125,13 @@ func resourceGitlabGroupLdapLinkRead(d *schema.ResourceData, meta interface{}) e
 
 	// Try to fetch all group links from GitLab
 	log.Printf("[DEBUG] Read GitLab group LdapLinks %s", groupId)
-	ldapLinks, _, err := client.Groups.ListGroupLDAPLinks(groupId, nil)
+	ldapLinks, resp, err := listGroupLDAPLinks(client, groupId)
 	if err != nil {
 		// The read/GET API wasn't implemented in GitLab until version 12.8 (March 2020, well after the add and delete APIs).
 		// If we 404, assume GitLab is at an older version and take things on faith.
-		switch err.(type) {
-		case *gitlab.ErrorResponse:
-			if err.(*gitlab.ErrorResponse).Response.StatusCode == 404 {
-				log.Printf("[WARNING] This GitLab instance doesn't have the GET API for group_ldap_sync.  Please upgrade to 12.8 or later for best results.")
-			} else {
-				return err
-			}
-		default:
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARNING] This GitLab instance doesn't have the GET API for group_ldap_sync.  Please upgrade to 12.8 or later for best results.")
+		} else {
 			return err
 		}
 	}
@@ -111,10 +141,11 @@ func resourceGitlabGroupLdapLinkRead(d *schema.ResourceData, meta interface{}) e
 		// Check if the LDAP link exists in the returned list of links
 		found := false
 		for _, ldapLink := range ldapLinks {
-			if buildTwoPartID(&ldapLink.Provider, &ldapLink.CN) == d.Id() {
+			if ldapGroupLinkID(ldapLink) == d.Id() {
 				d.Set("group_id", groupId)
 				d.Set("cn", ldapLink.CN)
-				d.Set("group_access", ldapLink.GroupAccess)
+				d.Set("filter", ldapLink.Filter)
+				d.Set("access_level", accessLevel[ldapLink.GroupAccess])
 				d.Set("ldap_provider", ldapLink.Provider)
 				found = true
 				break
@@ -134,10 +165,16 @@ func resourceGitlabGroupLdapLinkDelete(d *schema.ResourceData, meta interface{})
 	client := meta.(*gitlab.Client)
 	groupId := d.Get("group_id").(string)
 	cn := d.Get("cn").(string)
+	filter := d.Get("filter").(string)
 	ldap_provider := d.Get("ldap_provider").(string)
 
 	log.Printf("[DEBUG] Delete GitLab group LdapLink %s", d.Id())
-	_, err := client.Groups.DeleteGroupLDAPLinkForProvider(groupId, ldap_provider, cn)
+	var err error
+	if cn != "" {
+		_, err = client.Groups.DeleteGroupLDAPLinkForProvider(groupId, ldap_provider, cn)
+	} else {
+		err = deleteGroupLDAPFilterLink(client, groupId, ldap_provider, filter)
+	}
 	if err != nil {
 		switch err.(type) {
 		case *gitlab.ErrorResponse:
@@ -154,3 +191,58 @@ func resourceGitlabGroupLdapLinkDelete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+// resourceGitlabGroupLdapLinkImport imports a link from a "group_id:ldap_provider:cn"
+// or "group_id:ldap_provider:filter" ID, as the ID of the resource doesn't include
+// the group.
+func resourceGitlabGroupLdapLinkImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid LDAP link ID %q, expected group_id:ldap_provider:cn or group_id:ldap_provider:filter", d.Id())
+	}
+
+	d.Set("group_id", parts[0])
+	d.Set("force", false)
+	d.SetId(buildTwoPartID(&parts[1], &parts[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// ldapGroupLinkID returns the "ldap_provider:cn" or "ldap_provider:filter" ID of
+// a link.
+func ldapGroupLinkID(link *ldapGroupLink) string {
+	if link.CN != "" {
+		return buildTwoPartID(&link.Provider, &link.CN)
+	}
+	return buildTwoPartID(&link.Provider, &link.Filter)
+}
+
+func listGroupLDAPLinks(client *gitlab.Client, group string) ([]*ldapGroupLink, *gitlab.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/ldap_group_links", pathEscape(group)), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var links []*ldapGroupLink
+	resp, err := client.Do(req, &links)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return links, resp, nil
+}
+
+func deleteGroupLDAPFilterLink(client *gitlab.Client, group, provider, filter string) error {
+	options := &ldapGroupLinkOptions{
+		Filter:   &filter,
+		Provider: &provider,
+	}
+
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("groups/%s/ldap_group_links", pathEscape(group)), options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
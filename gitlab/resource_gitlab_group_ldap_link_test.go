@@ -52,6 +52,15 @@ func TestAccGitlabGroupLdapLink_basic(t *testing.T) {
 					})),
 			},
 
+			// Import the group LDAP link
+			{
+				SkipFunc:          testAccGitlabGroupLdapLinkSkipFunc(testLdapLink.CN, testLdapLink.Provider),
+				ResourceName:      "gitlab_group_ldap_link.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGitlabGroupLdapLinkImportStateIdFunc("gitlab_group_ldap_link.foo"),
+				ImportStateVerify: true,
+			},
+
 			// Force create the same group LDAP link in a different resource (uses testAccGitlabGroupLdapLinkForceCreateConfig for Config)
 			{
 				SkipFunc: testAccGitlabGroupLdapLinkSkipFunc(testLdapLink.CN, testLdapLink.Provider),
@@ -66,6 +75,56 @@ func TestAccGitlabGroupLdapLink_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroupLdapLink_filter(t *testing.T) {
+	var testLdapLink gitlab.LDAPGroupLink
+	rInt := acctest.RandInt()
+	testDataFile := "testdata/resource_gitlab_group_ldap_link.json"
+
+	// PreCheck runs after Config so load test data here
+	err := testAccLoadTestData(testDataFile, &testLdapLink)
+	if err != nil {
+		t.Fatalf("[ERROR] Failed to load test data: %s", err.Error())
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupLdapLinkDestroy,
+		Steps: []resource.TestStep{
+
+			// Create a filter-based group LDAP link (uses testAccGitlabGroupLdapLinkFilterConfig for Config)
+			{
+				SkipFunc: testAccGitlabGroupLdapLinkSkipFunc(testLdapLink.CN, testLdapLink.Provider),
+				Config:   testAccGitlabGroupLdapLinkFilterConfig(rInt, &testLdapLink),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_ldap_link.foo", "filter", fmt.Sprintf("(cn=%s)", testLdapLink.CN)),
+					resource.TestCheckResourceAttr("gitlab_group_ldap_link.foo", "cn", ""),
+					resource.TestCheckResourceAttr("gitlab_group_ldap_link.foo", "access_level", "developer"),
+				),
+			},
+
+			// Import the filter-based group LDAP link
+			{
+				SkipFunc:          testAccGitlabGroupLdapLinkSkipFunc(testLdapLink.CN, testLdapLink.Provider),
+				ResourceName:      "gitlab_group_ldap_link.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGitlabGroupLdapLinkImportStateIdFunc("gitlab_group_ldap_link.foo"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGitlabGroupLdapLinkImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		resourceState, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", resourceState.Primary.Attributes["group_id"], resourceState.Primary.ID), nil
+	}
+}
+
 func testAccGitlabGroupLdapLinkSkipFunc(testCN string, testProvider string) func() (bool, error) {
 	return func() (bool, error) {
 		if testCN == "default" || testProvider == "default" {
@@ -265,3 +324,19 @@ resource "gitlab_group_ldap_link" "bar" {
 	force			= true
 }`, rInt, rInt, testLdapLink.CN, testLdapLink.Provider, testLdapLink.CN, testLdapLink.Provider)
 }
+
+func testAccGitlabGroupLdapLinkFilterConfig(rInt int, testLdapLink *gitlab.LDAPGroupLink) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+    name = "foo%d"
+	path = "foo%d"
+	description = "Terraform acceptance test - Group LDAP Links 4"
+}
+
+resource "gitlab_group_ldap_link" "foo" {
+    group_id 		= "${gitlab_group.foo.id}"
+    filter			= "(cn=%s)"
+	access_level 	= "developer"
+	ldap_provider   = "%s"
+}`, rInt, rInt, testLdapLink.CN, testLdapLink.Provider)
+}
@@ -0,0 +1,254 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// https://docs.gitlab.com/ee/api/merge_request_approvals.html#group-mr-approvals-settings
+func resourceGitlabGroupLevelMRApprovals() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupLevelMRApprovalsCreate,
+		Read:   resourceGitlabGroupLevelMRApprovalsRead,
+		Update: resourceGitlabGroupLevelMRApprovalsUpdate,
+		Delete: resourceGitlabGroupLevelMRApprovalsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeInt,
+				ForceNew: true,
+				Required: true,
+			},
+			"reset_approvals_on_push": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"disable_overriding_approvers_per_merge_request": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"merge_requests_author_approval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"merge_requests_disable_committers_approval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"require_password_to_approve": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"locked_settings": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"inherited_settings": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceGitlabGroupLevelMRApprovalsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	groupId := d.Get("group_id").(int)
+
+	options := &groupApprovalSettingsOptions{
+		RetainApprovalsOnPush:                       gitlab.Bool(!d.Get("reset_approvals_on_push").(bool)),
+		AllowOverridesToApproverListPerMergeRequest: gitlab.Bool(!d.Get("disable_overriding_approvers_per_merge_request").(bool)),
+		AllowAuthorApproval:                         gitlab.Bool(d.Get("merge_requests_author_approval").(bool)),
+		AllowCommitterApproval:                      gitlab.Bool(!d.Get("merge_requests_disable_committers_approval").(bool)),
+		RequirePasswordToApprove:                    gitlab.Bool(d.Get("require_password_to_approve").(bool)),
+	}
+
+	log.Printf("[DEBUG] Creating new MR approval configuration for group %d:", groupId)
+
+	if err := changeGroupApprovalSettings(client, strconv.Itoa(groupId), options); err != nil {
+		return fmt.Errorf("couldn't create approval configuration: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(groupId))
+	return resourceGitlabGroupLevelMRApprovalsRead(d, meta)
+}
+
+func resourceGitlabGroupLevelMRApprovalsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	groupId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("group ID must be an integer (was %q): %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Reading gitlab approval configuration for group %d", groupId)
+
+	settings, err := getGroupApprovalSettings(client, d.Id())
+	if err != nil {
+		return fmt.Errorf("couldn't read approval configuration: %w", err)
+	}
+
+	d.Set("group_id", groupId)
+	d.Set("reset_approvals_on_push", !settings.RetainApprovalsOnPush.Value)
+	d.Set("disable_overriding_approvers_per_merge_request", !settings.AllowOverridesToApproverListPerMergeRequest.Value)
+	d.Set("merge_requests_author_approval", settings.AllowAuthorApproval.Value)
+	d.Set("merge_requests_disable_committers_approval", !settings.AllowCommitterApproval.Value)
+	d.Set("require_password_to_approve", settings.RequirePasswordToApprove.Value)
+
+	lockedSettings := []string{}
+	inheritedSettings := map[string]string{}
+	for attribute, setting := range settings.byAttribute() {
+		if setting.Locked {
+			lockedSettings = append(lockedSettings, attribute)
+		}
+		if setting.InheritedFrom != "" {
+			inheritedSettings[attribute] = setting.InheritedFrom
+		}
+	}
+
+	if err := d.Set("locked_settings", lockedSettings); err != nil {
+		return err
+	}
+
+	if err := d.Set("inherited_settings", inheritedSettings); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupLevelMRApprovalsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	options := &groupApprovalSettingsOptions{}
+
+	groupId := d.Id()
+	log.Printf("[DEBUG] Updating approval configuration for group %s:", groupId)
+
+	if d.HasChange("reset_approvals_on_push") {
+		options.RetainApprovalsOnPush = gitlab.Bool(!d.Get("reset_approvals_on_push").(bool))
+	}
+	if d.HasChange("disable_overriding_approvers_per_merge_request") {
+		options.AllowOverridesToApproverListPerMergeRequest = gitlab.Bool(!d.Get("disable_overriding_approvers_per_merge_request").(bool))
+	}
+	if d.HasChange("merge_requests_author_approval") {
+		options.AllowAuthorApproval = gitlab.Bool(d.Get("merge_requests_author_approval").(bool))
+	}
+	if d.HasChange("merge_requests_disable_committers_approval") {
+		options.AllowCommitterApproval = gitlab.Bool(!d.Get("merge_requests_disable_committers_approval").(bool))
+	}
+	if d.HasChange("require_password_to_approve") {
+		options.RequirePasswordToApprove = gitlab.Bool(d.Get("require_password_to_approve").(bool))
+	}
+
+	if err := changeGroupApprovalSettings(client, groupId, options); err != nil {
+		return fmt.Errorf("couldn't update approval configuration: %w", err)
+	}
+
+	return resourceGitlabGroupLevelMRApprovalsRead(d, meta)
+}
+
+func resourceGitlabGroupLevelMRApprovalsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	groupId := d.Id()
+
+	options := &groupApprovalSettingsOptions{
+		RetainApprovalsOnPush:                       gitlab.Bool(false),
+		AllowOverridesToApproverListPerMergeRequest: gitlab.Bool(true),
+		AllowAuthorApproval:                         gitlab.Bool(false),
+		AllowCommitterApproval:                      gitlab.Bool(true),
+		RequirePasswordToApprove:                    gitlab.Bool(false),
+	}
+
+	log.Printf("[DEBUG] Resetting approval configuration for group %s:", groupId)
+
+	if err := changeGroupApprovalSettings(client, groupId, options); err != nil {
+		return fmt.Errorf("couldn't reset approval configuration: %w", err)
+	}
+
+	return nil
+}
+
+// groupApprovalSetting represents a single merge request approval setting of a
+// group, which may be locked or inherited from the instance or a parent group.
+type groupApprovalSetting struct {
+	Value         bool   `json:"value"`
+	Locked        bool   `json:"locked"`
+	InheritedFrom string `json:"inherited_from"`
+}
+
+// groupApprovalSettings represents the merge request approval settings of a group.
+type groupApprovalSettings struct {
+	AllowAuthorApproval                         groupApprovalSetting `json:"allow_author_approval"`
+	AllowCommitterApproval                      groupApprovalSetting `json:"allow_committer_approval"`
+	AllowOverridesToApproverListPerMergeRequest groupApprovalSetting `json:"allow_overrides_to_approver_list_per_merge_request"`
+	RetainApprovalsOnPush                       groupApprovalSetting `json:"retain_approvals_on_push"`
+	RequirePasswordToApprove                    groupApprovalSetting `json:"require_password_to_approve"`
+}
+
+// byAttribute maps the resource attributes to the group approval settings backing them.
+func (s *groupApprovalSettings) byAttribute() map[string]groupApprovalSetting {
+	return map[string]groupApprovalSetting{
+		"reset_approvals_on_push":                        s.RetainApprovalsOnPush,
+		"disable_overriding_approvers_per_merge_request": s.AllowOverridesToApproverListPerMergeRequest,
+		"merge_requests_author_approval":                 s.AllowAuthorApproval,
+		"merge_requests_disable_committers_approval":     s.AllowCommitterApproval,
+		"require_password_to_approve":                    s.RequirePasswordToApprove,
+	}
+}
+
+// groupApprovalSettingsOptions represents the options to update the merge request
+// approval settings of a group.
+type groupApprovalSettingsOptions struct {
+	AllowAuthorApproval                         *bool `json:"allow_author_approval,omitempty"`
+	AllowCommitterApproval                      *bool `json:"allow_committer_approval,omitempty"`
+	AllowOverridesToApproverListPerMergeRequest *bool `json:"allow_overrides_to_approver_list_per_merge_request,omitempty"`
+	RetainApprovalsOnPush                       *bool `json:"retain_approvals_on_push,omitempty"`
+	RequirePasswordToApprove                    *bool `json:"require_password_to_approve,omitempty"`
+}
+
+func getGroupApprovalSettings(client *gitlab.Client, group string) (*groupApprovalSettings, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/merge_request_approval_setting", pathEscape(group)), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := new(groupApprovalSettings)
+	if _, err := client.Do(req, settings); err != nil {
+		return nil, augmentGroupApprovalSettingsClientError(err)
+	}
+
+	return settings, nil
+}
+
+func changeGroupApprovalSettings(client *gitlab.Client, group string, options *groupApprovalSettingsOptions) error {
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("groups/%s/merge_request_approval_setting", pathEscape(group)), options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return augmentGroupApprovalSettingsClientError(err)
+}
+
+func augmentGroupApprovalSettingsClientError(err error) error {
+	// The group approval settings API only exists in recent GitLab versions, older ones answer with a 404.
+	var httpErr *gitlab.ErrorResponse
+	if errors.As(err, &httpErr) && httpErr.Response.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Failed to manage group approval settings: %v", err)
+		return errors.New("Group merge request approval settings are not supported in your version of GitLab")
+	}
+
+	return err
+}
@@ -0,0 +1,112 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupLevelMRApprovals_basic(t *testing.T) {
+	var settings groupApprovalSettings
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabGroupLevelMRApprovalsConfig(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupLevelMRApprovalsExists("gitlab_group_level_mr_approvals.foo", &settings),
+					testAccCheckGitlabGroupLevelMRApprovalsAttributes(&settings, true),
+				),
+			},
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabGroupLevelMRApprovalsConfig(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupLevelMRApprovalsExists("gitlab_group_level_mr_approvals.foo", &settings),
+					testAccCheckGitlabGroupLevelMRApprovalsAttributes(&settings, false),
+				),
+			},
+			{
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_group_level_mr_approvals.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckGitlabGroupLevelMRApprovalsAttributes checks the group settings against
+// a configuration where all attributes are set to want.
+func testAccCheckGitlabGroupLevelMRApprovalsAttributes(settings *groupApprovalSettings, want bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if settings.RetainApprovalsOnPush.Value == want {
+			return fmt.Errorf("got retain_approvals_on_push %t; want %t", settings.RetainApprovalsOnPush.Value, !want)
+		}
+		if settings.AllowOverridesToApproverListPerMergeRequest.Value == want {
+			return fmt.Errorf("got allow_overrides_to_approver_list_per_merge_request %t; want %t", settings.AllowOverridesToApproverListPerMergeRequest.Value, !want)
+		}
+		if settings.AllowAuthorApproval.Value != want {
+			return fmt.Errorf("got allow_author_approval %t; want %t", settings.AllowAuthorApproval.Value, want)
+		}
+		if settings.AllowCommitterApproval.Value == want {
+			return fmt.Errorf("got allow_committer_approval %t; want %t", settings.AllowCommitterApproval.Value, !want)
+		}
+		if settings.RequirePasswordToApprove.Value != want {
+			return fmt.Errorf("got require_password_to_approve %t; want %t", settings.RequirePasswordToApprove.Value, want)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabGroupLevelMRApprovalsExists(n string, settings *groupApprovalSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		groupId := rs.Primary.ID
+		if groupId == "" {
+			return fmt.Errorf("No group ID is set")
+		}
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		gotSettings, err := getGroupApprovalSettings(conn, groupId)
+		if err != nil {
+			return err
+		}
+
+		*settings = *gotSettings
+		return nil
+	}
+}
+
+func testAccGitlabGroupLevelMRApprovalsConfig(rInt int, value bool) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+	name             = "foo-%[1]d"
+	path             = "foo-%[1]d"
+	description      = "Terraform acceptance tests"
+	visibility_level = "public"
+}
+
+resource "gitlab_group_level_mr_approvals" "foo" {
+	group_id                                       = gitlab_group.foo.id
+	reset_approvals_on_push                        = %[2]t
+	disable_overriding_approvers_per_merge_request = %[2]t
+	merge_requests_author_approval                 = %[2]t
+	merge_requests_disable_committers_approval     = %[2]t
+	require_password_to_approve                    = %[2]t
+}
+	`, rInt, value)
+}
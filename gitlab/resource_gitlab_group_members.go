@@ -0,0 +1,132 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupMembers() *schema.Resource {
+	acceptedAccessLevels := make([]string, 0, len(accessLevelID))
+	for k := range accessLevelID {
+		acceptedAccessLevels = append(acceptedAccessLevels, k)
+	}
+	return &schema.Resource{
+		Create: resourceGitlabGroupMembersCreate,
+		Read:   resourceGitlabGroupMembersRead,
+		Update: resourceGitlabGroupMembersUpdate,
+		Delete: resourceGitlabGroupMembersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: membersSchema("group_id", acceptedAccessLevels),
+	}
+}
+
+type groupMembersAPI struct {
+	client *gitlab.Client
+	group  string
+}
+
+func (a groupMembersAPI) list() ([]*member, *gitlab.Response, error) {
+	options := &gitlab.ListGroupMembersOptions{
+		ListOptions: gitlab.ListOptions{Page: 1, PerPage: 100},
+	}
+
+	var members []*member
+	for {
+		page, resp, err := a.client.Groups.ListGroupMembers(a.group, options)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, m := range page {
+			members = append(members, &member{
+				userID:      m.ID,
+				accessLevel: m.AccessLevel,
+				expiresAt:   flattenISOTime(m.ExpiresAt),
+			})
+		}
+
+		if resp.NextPage == 0 {
+			return members, resp, nil
+		}
+
+		options.Page = resp.NextPage
+	}
+}
+
+func (a groupMembersAPI) add(m *member) error {
+	_, _, err := a.client.GroupMembers.AddGroupMember(a.group, &gitlab.AddGroupMemberOptions{
+		UserID:      &m.userID,
+		AccessLevel: &m.accessLevel,
+		ExpiresAt:   &m.expiresAt,
+	})
+	return err
+}
+
+func (a groupMembersAPI) edit(m *member) error {
+	_, _, err := a.client.GroupMembers.EditGroupMember(a.group, m.userID, &gitlab.EditGroupMemberOptions{
+		AccessLevel: &m.accessLevel,
+		ExpiresAt:   &m.expiresAt,
+	})
+	return err
+}
+
+func (a groupMembersAPI) remove(userID int) (*gitlab.Response, error) {
+	return a.client.GroupMembers.RemoveGroupMember(a.group, userID)
+}
+
+func resourceGitlabGroupMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("group_id").(string))
+	return resourceGitlabGroupMembersUpdate(d, meta)
+}
+
+func resourceGitlabGroupMembersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] read gitlab group %s members", group)
+
+	members, resp, err := groupMembersAPI{client, group}.list()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab group %s not found so removing its members from state", group)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group_id", group)
+	if err := d.Set("member", flattenMembers(members, d)); err != nil {
+		return fmt.Errorf("error setting members: %w", err)
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] update gitlab group %s members", group)
+
+	if err := syncMembers(groupMembersAPI{client, group}, d); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupMembersRead(d, meta)
+}
+
+func resourceGitlabGroupMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab group %s members", group)
+
+	return deleteDeclaredMembers(groupMembersAPI{client, group}, d)
+}
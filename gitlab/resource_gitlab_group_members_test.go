@@ -0,0 +1,121 @@
+package gitlab
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupMembers_basic(t *testing.T) {
+	currentUser := testAccCurrentUser(t)
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			// Add two members.
+			{
+				Config: testAccGitlabGroupMembersConfig(rInt, currentUser.ID, `
+  member {
+    user_id      = gitlab_user.foo.id
+    access_level = "developer"
+  }
+
+  member {
+    user_id      = gitlab_user.bar.id
+    access_level = "owner"
+    expires_at   = "2099-12-31"
+  }
+`),
+				Check: testAccCheckGitlabGroupMembers(fmt.Sprintf("foo-path-%d", rInt), currentUser.ID, map[string]string{
+					fmt.Sprintf("foo%d", rInt): "developer",
+					fmt.Sprintf("bar%d", rInt): "owner until 2099-12-31",
+				}),
+			},
+			// Update a member and remove one.
+			{
+				Config: testAccGitlabGroupMembersConfig(rInt, currentUser.ID, `
+  member {
+    user_id      = gitlab_user.foo.id
+    access_level = "maintainer"
+  }
+`),
+				Check: testAccCheckGitlabGroupMembers(fmt.Sprintf("foo-path-%d", rInt), currentUser.ID, map[string]string{
+					fmt.Sprintf("foo%d", rInt): "maintainer",
+				}),
+			},
+			// All direct members are imported, including the excluded current user.
+			{
+				ResourceName:            "gitlab_group_members.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"exclude_user_ids", "member"},
+				ImportStateCheck: testAccCheckGitlabImportedMembers(currentUser.ID, map[string]string{
+					fmt.Sprintf("foo%d", rInt): "maintainer",
+				}),
+			},
+		},
+	})
+}
+
+// testAccCheckGitlabGroupMembers checks the members of a group, other than the
+// current user, by username.
+func testAccCheckGitlabGroupMembers(group string, currentUserID int, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		members, _, err := conn.Groups.ListGroupMembers(group, nil)
+		if err != nil {
+			return err
+		}
+
+		got := make(map[string]string)
+		for _, m := range members {
+			if m.ID == currentUserID {
+				continue
+			}
+			got[m.Username] = describeTestMember(m.AccessLevel, m.ExpiresAt)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("got members %v; want %v", got, want)
+		}
+		return nil
+	}
+}
+
+func testAccGitlabGroupMembersConfig(rInt, currentUserID int, members string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%[1]d"
+  path = "foo-path-%[1]d"
+}
+
+resource "gitlab_user" "foo" {
+  name     = "foo %[1]d"
+  username = "foo%[1]d"
+  password = "test%[1]dtt"
+  email    = "foo%[1]d@ssss.com"
+}
+
+resource "gitlab_user" "bar" {
+  name     = "bar %[1]d"
+  username = "bar%[1]d"
+  password = "test%[1]dtt"
+  email    = "bar%[1]d@ssss.com"
+}
+
+resource "gitlab_group_members" "foo" {
+  group_id         = gitlab_group.foo.id
+  exclude_user_ids = [%[2]d]
+%[3]s
+}
+`, rInt, currentUserID, members)
+}
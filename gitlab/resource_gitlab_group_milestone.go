@@ -0,0 +1,150 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupMilestone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupMilestoneCreate,
+		Read:   resourceGitlabGroupMilestoneRead,
+		Update: resourceGitlabGroupMilestoneUpdate,
+		Delete: resourceGitlabGroupMilestoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: milestoneSchema("group", nil),
+	}
+}
+
+func resourceGitlabGroupMilestoneCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	options := &gitlab.CreateGroupMilestoneOptions{
+		Title:     gitlab.String(d.Get("title").(string)),
+		StartDate: expandMilestoneDate(d, "start_date"),
+		DueDate:   expandMilestoneDate(d, "due_date"),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab group %s milestone %q", group, *options.Title)
+
+	milestone, _, err := client.GroupMilestones.CreateGroupMilestone(group, options)
+	if err != nil {
+		return err
+	}
+
+	milestoneID := strconv.Itoa(milestone.ID)
+	d.SetId(buildTwoPartID(&group, &milestoneID))
+
+	// Milestones are always created active, so closing them takes another request.
+	if d.Get("state").(string) == "closed" {
+		_, _, err := client.GroupMilestones.UpdateGroupMilestone(group, milestone.ID, &gitlab.UpdateGroupMilestoneOptions{
+			StateEvent: gitlab.String("close"),
+		})
+		if err != nil {
+			return fmt.Errorf("new milestone %q could not be closed: %w", d.Id(), err)
+		}
+	}
+
+	return resourceGitlabGroupMilestoneRead(d, meta)
+}
+
+func resourceGitlabGroupMilestoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, milestoneID, err := parseTwoPartIntID(d.Id(), "milestone")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab group %s milestone %d", group, milestoneID)
+
+	milestone, resp, err := client.GroupMilestones.GetGroupMilestone(group, milestoneID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab group milestone %s not found so removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group", group)
+	d.Set("title", milestone.Title)
+	d.Set("description", milestone.Description)
+	d.Set("start_date", flattenMilestoneDate(milestone.StartDate))
+	d.Set("due_date", flattenMilestoneDate(milestone.DueDate))
+	d.Set("state", milestone.State)
+	d.Set("milestone_id", milestone.ID)
+	d.Set("iid", milestone.IID)
+	d.Set("expired", milestone.Expired != nil && *milestone.Expired)
+
+	return nil
+}
+
+func resourceGitlabGroupMilestoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, milestoneID, err := parseTwoPartIntID(d.Id(), "milestone")
+	if err != nil {
+		return err
+	}
+
+	options := &gitlab.UpdateGroupMilestoneOptions{
+		StartDate:  expandMilestoneDate(d, "start_date"),
+		DueDate:    expandMilestoneDate(d, "due_date"),
+		StateEvent: expandMilestoneStateEvent(d),
+	}
+
+	if d.HasChange("title") {
+		options.Title = gitlab.String(d.Get("title").(string))
+	}
+
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+
+	log.Printf("[DEBUG] update gitlab group %s milestone %d", group, milestoneID)
+
+	if _, _, err := client.GroupMilestones.UpdateGroupMilestone(group, milestoneID, options); err != nil {
+		return err
+	}
+
+	if err := clearMilestoneDates(client, groupMilestonePath(group, milestoneID), d); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupMilestoneRead(d, meta)
+}
+
+func resourceGitlabGroupMilestoneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, milestoneID, err := parseTwoPartIntID(d.Id(), "milestone")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab group %s milestone %d", group, milestoneID)
+
+	// go-gitlab has no method to delete group milestones yet.
+	req, err := client.NewRequest(http.MethodDelete, groupMilestonePath(group, milestoneID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func groupMilestonePath(group string, milestoneID int) string {
+	return fmt.Sprintf("groups/%s/milestones/%d", pathEscape(group), milestoneID)
+}
@@ -0,0 +1,104 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupMilestone_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupMilestoneDestroy,
+		Steps: []resource.TestStep{
+			// Create a milestone with dates
+			{
+				Config: testAccGitlabGroupMilestoneConfig(rInt, `
+start_date = "2021-01-01"
+due_date   = "2021-03-31"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "title", fmt.Sprintf("Q1 %d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "start_date", "2021-01-01"),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "due_date", "2021-03-31"),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "state", "active"),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "expired", "true"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_milestone.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Close the milestone and remove its dates
+			{
+				Config: testAccGitlabGroupMilestoneConfig(rInt, `state = "closed"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "start_date", ""),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "due_date", ""),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "state", "closed"),
+				),
+			},
+			// Reopen the milestone
+			{
+				Config: testAccGitlabGroupMilestoneConfig(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupMilestoneDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_milestone" {
+			continue
+		}
+
+		group, milestoneID, err := parseTwoPartIntID(rs.Primary.ID, "milestone")
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := client.GroupMilestones.GetGroupMilestone(group, milestoneID)
+		if err == nil {
+			return fmt.Errorf("group milestone %s still exists", rs.Primary.ID)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccGitlabGroupMilestoneConfig(rInt int, attributes string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%[1]d"
+  path = "foo-path-%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_group_milestone" "foo" {
+  group     = gitlab_group.foo.id
+  title       = "Q1 %[1]d"
+  description = "First quarter"
+%[2]s
+}
+	`, rInt, attributes)
+}
@@ -0,0 +1,174 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupPushRules() *schema.Resource {
+	s := projectPushRulesSchema()
+	s["group"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceGitlabGroupPushRulesCreate,
+		Read:   resourceGitlabGroupPushRulesRead,
+		Update: resourceGitlabGroupPushRulesUpdate,
+		Delete: resourceGitlabGroupPushRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: s,
+	}
+}
+
+func resourceGitlabGroupPushRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	log.Printf("[DEBUG] create gitlab group %s push rules", group)
+
+	// The group may already have push rules, in which case they are taken over.
+	pushRules, resp, err := client.Groups.GetGroupPushRules(group)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("Failed to get push rules for group %q: %w", group, err)
+	}
+
+	if err == nil && pushRules.ID != 0 {
+		_, _, err = client.Groups.EditGroupPushRule(group, expandEditGroupPushRuleOptions(d))
+	} else {
+		_, _, err = client.Groups.AddGroupPushRule(group, expandAddGroupPushRuleOptions(d))
+	}
+	if err != nil {
+		return augmentGroupPushRulesClientError(group, err)
+	}
+
+	d.SetId(group)
+
+	return resourceGitlabGroupPushRulesRead(d, meta)
+}
+
+func resourceGitlabGroupPushRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read gitlab group %s push rules", d.Id())
+
+	pushRules, resp, err := client.Groups.GetGroupPushRules(d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab group %s push rules not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if pushRules.ID == 0 {
+		log.Printf("[DEBUG] gitlab group %s has no push rules, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group", d.Id())
+	for key, value := range flattenGroupPushRules(pushRules) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupPushRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] update gitlab group %s push rules", d.Id())
+
+	if _, _, err := client.Groups.EditGroupPushRule(d.Id(), expandEditGroupPushRuleOptions(d)); err != nil {
+		return augmentGroupPushRulesClientError(d.Id(), err)
+	}
+
+	return resourceGitlabGroupPushRulesRead(d, meta)
+}
+
+func resourceGitlabGroupPushRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] delete gitlab group %s push rules", d.Id())
+
+	resp, err := client.Groups.DeleteGroupPushRule(d.Id())
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func augmentGroupPushRulesClientError(group string, err error) error {
+	var httpError *gitlab.ErrorResponse
+	if errors.As(err, &httpError) && httpError.Response.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Failed to edit push rules for group %q: %v", group, err)
+		return errors.New("Group push rules are not supported in your version of GitLab")
+	}
+
+	return fmt.Errorf("Failed to edit push rules for group %q: %w", group, err)
+}
+
+// The group push rules have the same attributes as the project ones, so their
+// options are built with the project push rules schema helpers.
+
+func expandAddGroupPushRuleOptions(d *schema.ResourceData) *gitlab.AddGroupPushRuleOptions {
+	options := expandAddProjectPushRuleOptions(d, "")
+	return &gitlab.AddGroupPushRuleOptions{
+		AuthorEmailRegex:           options.AuthorEmailRegex,
+		BranchNameRegex:            options.BranchNameRegex,
+		CommitMessageRegex:         options.CommitMessageRegex,
+		CommitMessageNegativeRegex: options.CommitMessageNegativeRegex,
+		FileNameRegex:              options.FileNameRegex,
+		CommitCommitterCheck:       options.CommitCommitterCheck,
+		DenyDeleteTag:              options.DenyDeleteTag,
+		MemberCheck:                options.MemberCheck,
+		PreventSecrets:             options.PreventSecrets,
+		RejectUnsignedCommits:      options.RejectUnsignedCommits,
+		MaxFileSize:                options.MaxFileSize,
+	}
+}
+
+func expandEditGroupPushRuleOptions(d *schema.ResourceData) *gitlab.EditGroupPushRuleOptions {
+	options := expandEditProjectPushRuleOptions(d, "")
+	return &gitlab.EditGroupPushRuleOptions{
+		AuthorEmailRegex:           options.AuthorEmailRegex,
+		BranchNameRegex:            options.BranchNameRegex,
+		CommitMessageRegex:         options.CommitMessageRegex,
+		CommitMessageNegativeRegex: options.CommitMessageNegativeRegex,
+		FileNameRegex:              options.FileNameRegex,
+		CommitCommitterCheck:       options.CommitCommitterCheck,
+		DenyDeleteTag:              options.DenyDeleteTag,
+		MemberCheck:                options.MemberCheck,
+		PreventSecrets:             options.PreventSecrets,
+		RejectUnsignedCommits:      options.RejectUnsignedCommits,
+		MaxFileSize:                options.MaxFileSize,
+	}
+}
+
+func flattenGroupPushRules(pushRules *gitlab.GroupPushRules) map[string]interface{} {
+	return map[string]interface{}{
+		"author_email_regex":            pushRules.AuthorEmailRegex,
+		"branch_name_regex":             pushRules.BranchNameRegex,
+		"commit_message_regex":          pushRules.CommitMessageRegex,
+		"commit_message_negative_regex": pushRules.CommitMessageNegativeRegex,
+		"file_name_regex":               pushRules.FileNameRegex,
+		"commit_committer_check":        pushRules.CommitCommitterCheck,
+		"deny_delete_tag":               pushRules.DenyDeleteTag,
+		"member_check":                  pushRules.MemberCheck,
+		"prevent_secrets":               pushRules.PreventSecrets,
+		"reject_unsigned_commits":       pushRules.RejectUnsignedCommits,
+		"max_file_size":                 pushRules.MaxFileSize,
+	}
+}
@@ -0,0 +1,134 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupPushRules_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			// Add all push rules to a project
+			{
+				SkipFunc: isRunningInCE,
+				Config: testAccGitlabGroupPushRulesConfig(rInt, `
+author_email_regex = "foo_author"
+branch_name_regex = "foo_branch"
+commit_message_regex = "foo_commit"
+commit_message_negative_regex = "foo_not_commit"
+file_name_regex = "foo_file"
+commit_committer_check = true
+deny_delete_tag = true
+member_check = true
+prevent_secrets = true
+reject_unsigned_commits = true
+max_file_size = 123
+`),
+				Check: testAccCheckGitlabGroupPushRules("gitlab_group_push_rules.foo", &gitlab.GroupPushRules{
+					AuthorEmailRegex:           "foo_author",
+					BranchNameRegex:            "foo_branch",
+					CommitMessageRegex:         "foo_commit",
+					CommitMessageNegativeRegex: "foo_not_commit",
+					FileNameRegex:              "foo_file",
+					CommitCommitterCheck:       true,
+					DenyDeleteTag:              true,
+					MemberCheck:                true,
+					PreventSecrets:             true,
+					RejectUnsignedCommits:      true,
+					MaxFileSize:                123,
+				}),
+			},
+			{
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_group_push_rules.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the push rules, unset attributes are cleared
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabGroupPushRulesConfig(rInt, `author_email_regex = "bar_author"`),
+				Check: testAccCheckGitlabGroupPushRules("gitlab_group_push_rules.foo", &gitlab.GroupPushRules{
+					AuthorEmailRegex: "bar_author",
+				}),
+			},
+			// Remove the push rules resource, the push rules are deleted
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabGroupConfig(rInt),
+				Check:    testAccCheckGitlabGroupPushRulesDeleted("gitlab_group.foo"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupPushRules(name string, want *gitlab.GroupPushRules) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		client := testAccProvider.Meta().(*gitlab.Client)
+		groupResource := state.RootModule().Resources[name].Primary
+
+		got, _, err := client.Groups.GetGroupPushRules(groupResource.ID)
+		if err != nil {
+			return err
+		}
+
+		// Only compare the attributes managed by the resource.
+		got.ID, got.CreatedAt = want.ID, want.CreatedAt
+		if *got != *want {
+			return fmt.Errorf("got push rules %+v; want %+v", *got, *want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGitlabGroupPushRulesDeleted(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		client := testAccProvider.Meta().(*gitlab.Client)
+		groupResource := state.RootModule().Resources[name].Primary
+
+		pushRules, resp, err := client.Groups.GetGroupPushRules(groupResource.ID)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if pushRules.ID != 0 {
+			return fmt.Errorf("push rules of group %s still exist", groupResource.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccGitlabGroupPushRulesConfig(rInt int, pushRules string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name        = "foo-name-%[1]d"
+  path        = "foo-path-%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_group_push_rules" "foo" {
+  group = gitlab_group.foo.id
+%[2]s
+}
+	`, rInt, pushRules)
+}
@@ -0,0 +1,230 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var groupIdentityTypes = []string{"saml", "scim"}
+
+func resourceGitlabGroupSamlIdentity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupSamlIdentityCreate,
+		Read:   resourceGitlabGroupSamlIdentityRead,
+		Update: resourceGitlabGroupSamlIdentityUpdate,
+		Delete: resourceGitlabGroupSamlIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "saml",
+				ValidateFunc: validation.StringInSlice(groupIdentityTypes, false),
+			},
+			"extern_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// groupIdentity is a SAML or SCIM identity of a user in a group. go-gitlab doesn't
+// support group identities yet, so they are read and written with raw requests.
+type groupIdentity struct {
+	ExternUID string `json:"extern_uid"`
+	UserID    int    `json:"user_id"`
+	Active    *bool  `json:"active"`
+}
+
+func resourceGitlabGroupSamlIdentityCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group_id").(string)
+	userID := d.Get("user_id").(int)
+	identityType := d.Get("type").(string)
+
+	// Identities are created by the identity provider when users sign in or are
+	// provisioned, so an existing identity is taken over and corrected.
+	identity, _, err := getGroupIdentity(client, group, identityType, userID)
+	if err != nil {
+		return err
+	}
+	if identity == nil {
+		return fmt.Errorf("user %d has no %s identity in group %s", userID, identityType, group)
+	}
+
+	if externUID := d.Get("extern_uid").(string); identity.ExternUID != externUID {
+		log.Printf("[DEBUG] update gitlab group %s %s identity of user %d", group, identityType, userID)
+
+		if err := updateGroupIdentity(client, group, identityType, identity.ExternUID, externUID); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%d", group, identityType, userID))
+
+	return resourceGitlabGroupSamlIdentityRead(d, meta)
+}
+
+func resourceGitlabGroupSamlIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, identityType, userID, err := parseGroupIdentityID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab group %s %s identity of user %d", group, identityType, userID)
+
+	identity, resp, err := getGroupIdentity(client, group, identityType, userID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab group %s not found so removing identity from state", group)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if identity == nil {
+		log.Printf("[DEBUG] gitlab group identity %s not found so removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_id", group)
+	d.Set("user_id", userID)
+	d.Set("type", identityType)
+	d.Set("extern_uid", identity.ExternUID)
+	// Only SCIM identities can be deactivated.
+	d.Set("active", identity.Active == nil || *identity.Active)
+
+	return nil
+}
+
+func resourceGitlabGroupSamlIdentityUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, identityType, userID, err := parseGroupIdentityID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("extern_uid") {
+		log.Printf("[DEBUG] update gitlab group %s %s identity of user %d", group, identityType, userID)
+
+		old, new := d.GetChange("extern_uid")
+		if err := updateGroupIdentity(client, group, identityType, old.(string), new.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceGitlabGroupSamlIdentityRead(d, meta)
+}
+
+// resourceGitlabGroupSamlIdentityDelete only removes the identity from the state.
+// Deleting it would unlink the user from the identity provider, and for SCIM
+// identities also remove the user from the group.
+func resourceGitlabGroupSamlIdentityDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] removing gitlab group identity %s from state", d.Id())
+	return nil
+}
+
+// parseGroupIdentityID parses the "group_id:type:user_id" ID of a group identity.
+func parseGroupIdentityID(id string) (string, string, int, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 {
+		return "", "", 0, fmt.Errorf("Unexpected ID format (%q). Expected group_id:type:user_id", id)
+	}
+
+	userID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to get user ID from %q: %w", id, err)
+	}
+
+	return parts[0], parts[1], userID, nil
+}
+
+// getGroupIdentity returns the identity of the user in the group, or nil if the
+// user has none.
+func getGroupIdentity(client *gitlab.Client, group, identityType string, userID int) (*groupIdentity, *gitlab.Response, error) {
+	identities, resp, err := listGroupIdentities(client, group, identityType)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, identity := range identities {
+		if identity.UserID == userID {
+			return identity, resp, nil
+		}
+	}
+
+	return nil, resp, nil
+}
+
+func listGroupIdentities(client *gitlab.Client, group, identityType string) ([]*groupIdentity, *gitlab.Response, error) {
+	options := &gitlab.ListOptions{Page: 1, PerPage: 100}
+
+	var identities []*groupIdentity
+	for {
+		req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/%s/identities", pathEscape(group), identityType), options, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page []*groupIdentity
+		resp, err := client.Do(req, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		identities = append(identities, page...)
+
+		if resp.NextPage == 0 {
+			return identities, resp, nil
+		}
+
+		options.Page = resp.NextPage
+	}
+}
+
+// updateGroupIdentity changes the extern UID of an identity, which is identified
+// by its current extern UID.
+func updateGroupIdentity(client *gitlab.Client, group, identityType, externUID, newExternUID string) error {
+	// PATCH requests send their options as query parameters, which needs a struct.
+	options := &struct {
+		ExternUID *string `url:"extern_uid,omitempty"`
+	}{
+		ExternUID: &newExternUID,
+	}
+
+	req, err := client.NewRequest(http.MethodPatch, fmt.Sprintf("groups/%s/%s/%s", pathEscape(group), identityType, pathEscape(externUID)), options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
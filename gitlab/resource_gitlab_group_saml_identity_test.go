@@ -0,0 +1,226 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+// testGroupIdentity is an identity of a user in a group with SAML single sign-on,
+// which the identity provider of the group created.
+type testGroupIdentity struct {
+	GroupID   string `json:"group_id"`
+	Type      string `json:"type"`
+	UserID    int    `json:"user_id"`
+	ExternUID string `json:"extern_uid"`
+}
+
+func TestAccGitlabGroupSamlIdentity_basic(t *testing.T) {
+	var testIdentity testGroupIdentity
+
+	// PreCheck runs after Config so load test data here
+	testIdentityBytes, err := ioutil.ReadFile("testdata/resource_gitlab_group_saml_identity.json")
+	if err != nil {
+		t.Fatalf("[ERROR] Failed to load test data: %s", err.Error())
+	}
+	if err := json.Unmarshal(testIdentityBytes, &testIdentity); err != nil {
+		t.Fatalf("[ERROR] Failed to load test data: %s", err.Error())
+	}
+
+	updatedExternUID := testIdentity.ExternUID + "-terraform"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupSamlIdentityKept(testIdentity),
+		Steps: []resource.TestStep{
+			// Take over the identity and read it with the data source
+			{
+				SkipFunc: testAccGitlabGroupSamlIdentitySkipFunc(testIdentity.GroupID),
+				Config:   testAccGitlabGroupSamlIdentityConfig(testIdentity, testIdentity.ExternUID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_saml_identity.foo", "extern_uid", testIdentity.ExternUID),
+					resource.TestCheckResourceAttr("gitlab_group_saml_identity.foo", "active", "true"),
+					testAccCheckGitlabGroupSamlIdentitiesContain("data.gitlab_group_saml_identities.foo", testIdentity.UserID, testIdentity.ExternUID),
+				),
+			},
+			// Change the extern UID
+			{
+				SkipFunc: testAccGitlabGroupSamlIdentitySkipFunc(testIdentity.GroupID),
+				Config:   testAccGitlabGroupSamlIdentityConfig(testIdentity, updatedExternUID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_saml_identity.foo", "extern_uid", updatedExternUID),
+					testAccCheckGitlabGroupSamlIdentityExternUID(testIdentity, updatedExternUID),
+				),
+			},
+			{
+				SkipFunc:          testAccGitlabGroupSamlIdentitySkipFunc(testIdentity.GroupID),
+				ResourceName:      "gitlab_group_saml_identity.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Restore the extern UID, so the user can still sign in
+			{
+				SkipFunc: testAccGitlabGroupSamlIdentitySkipFunc(testIdentity.GroupID),
+				Config:   testAccGitlabGroupSamlIdentityConfig(testIdentity, testIdentity.ExternUID),
+				Check:    testAccCheckGitlabGroupSamlIdentityExternUID(testIdentity, testIdentity.ExternUID),
+			},
+		},
+	})
+}
+
+// Group identities are created by the SAML or SCIM identity provider of a group,
+// so the tests are skipped unless the test data names an identity on the test instance.
+func testAccGitlabGroupSamlIdentitySkipFunc(testGroupID string) func() (bool, error) {
+	return func() (bool, error) {
+		if testGroupID == "default" {
+			return true, nil
+		}
+
+		return isRunningInCE()
+	}
+}
+
+func testAccCheckGitlabGroupSamlIdentityExternUID(identity testGroupIdentity, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		got, _, err := getGroupIdentity(conn, identity.GroupID, identity.Type, identity.UserID)
+		if err != nil {
+			return err
+		}
+		if got == nil {
+			return fmt.Errorf("user %d has no %s identity in group %s", identity.UserID, identity.Type, identity.GroupID)
+		}
+		if got.ExternUID != want {
+			return fmt.Errorf("got extern_uid %q; want %q", got.ExternUID, want)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckGitlabGroupSamlIdentityKept checks that destroying the resource
+// didn't delete the identity.
+func testAccCheckGitlabGroupSamlIdentityKept(identity testGroupIdentity) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if identity.GroupID == "default" {
+			return nil
+		}
+
+		return testAccCheckGitlabGroupSamlIdentityExternUID(identity, identity.ExternUID)(s)
+	}
+}
+
+func testAccCheckGitlabGroupSamlIdentitiesContain(n string, userID int, externUID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["identities.#"])
+		if err != nil {
+			return err
+		}
+		for i := 0; i < count; i++ {
+			prefix := fmt.Sprintf("identities.%d.", i)
+			if rs.Primary.Attributes[prefix+"user_id"] == strconv.Itoa(userID) {
+				if got := rs.Primary.Attributes[prefix+"extern_uid"]; got != externUID {
+					return fmt.Errorf("got extern_uid %q for user %d; want %q", got, userID, externUID)
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("user %d has no identity in %s", userID, n)
+	}
+}
+
+func testAccGitlabGroupSamlIdentityConfig(identity testGroupIdentity, externUID string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group_saml_identity" "foo" {
+  group_id   = "%[1]s"
+  user_id    = %[2]d
+  type       = "%[3]s"
+  extern_uid = "%[4]s"
+}
+
+data "gitlab_group_saml_identities" "foo" {
+  group_id = "%[1]s"
+  type     = "%[3]s"
+}
+`, identity.GroupID, identity.UserID, identity.Type, externUID)
+}
+
+func TestGroupIdentityRequests(t *testing.T) {
+	var patched string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/groups/42/scim/identities":
+			// Return the identities on two pages.
+			if r.URL.Query().Get("page") == "2" {
+				fmt.Fprint(w, `[{"extern_uid": "uid-2", "user_id": 2, "active": false}]`)
+				return
+			}
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"extern_uid": "uid-1", "user_id": 1, "active": true}]`)
+		case r.Method == http.MethodPatch && r.URL.EscapedPath() == "/api/v4/groups/42/saml/old%2Fuid":
+			patched = r.URL.Query().Get("extern_uid")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := gitlab.NewClient("token", gitlab.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identity, _, err := getGroupIdentity(client, "42", "scim", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity == nil || identity.ExternUID != "uid-2" || identity.Active == nil || *identity.Active {
+		t.Fatalf("got identity %+v on the second page; want the inactive identity uid-2", identity)
+	}
+
+	identity, _, err = getGroupIdentity(client, "42", "scim", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity != nil {
+		t.Fatalf("got identity %+v for a user without identity; want none", identity)
+	}
+
+	if err := updateGroupIdentity(client, "42", "saml", "old/uid", "new-uid"); err != nil {
+		t.Fatal(err)
+	}
+	if patched != "new-uid" {
+		t.Fatalf("got extern_uid %q; want %q", patched, "new-uid")
+	}
+}
+
+func TestParseGroupIdentityID(t *testing.T) {
+	group, identityType, userID, err := parseGroupIdentityID("42:scim:1337")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if group != "42" || identityType != "scim" || userID != 1337 {
+		t.Errorf("parseGroupIdentityID() = %q, %q, %d; want %q, %q, %d", group, identityType, userID, "42", "scim", 1337)
+	}
+
+	for _, id := range []string{"42:1337", "42:saml:user", "42:saml:1337:1"} {
+		if _, _, _, err := parseGroupIdentityID(id); err == nil {
+			t.Errorf("parseGroupIdentityID(%q) didn't return an error", id)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupSamlLink() *schema.Resource {
+	acceptedAccessLevels := make([]string, 0, len(accessLevelID))
+	for k := range accessLevelID {
+		acceptedAccessLevels = append(acceptedAccessLevels, k)
+	}
+	return &schema.Resource{
+		Create: resourceGitlabGroupSamlLinkCreate,
+		Read:   resourceGitlabGroupSamlLinkRead,
+		Delete: resourceGitlabGroupSamlLinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"saml_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_level": {
+				Type:         schema.TypeString,
+				ValidateFunc: validateValueFunc(acceptedAccessLevels),
+				Required:     true,
+				ForceNew:     true,
+			},
+		},
+	}
+}
+
+// samlGroupLink is a SAML group link of a group. go-gitlab doesn't support SAML
+// group links yet, so they are read and written with raw requests.
+type samlGroupLink struct {
+	Name        string                  `json:"name"`
+	AccessLevel gitlab.AccessLevelValue `json:"access_level"`
+}
+
+func resourceGitlabGroupSamlLinkCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	groupId := d.Get("group_id").(string)
+	samlGroupName := d.Get("saml_group_name").(string)
+
+	options := map[string]interface{}{
+		"saml_group_name": samlGroupName,
+		"access_level":    accessLevelNameToValue[d.Get("access_level").(string)],
+	}
+
+	log.Printf("[DEBUG] create gitlab group %s SAML link %q", groupId, samlGroupName)
+
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/saml_group_links", pathEscape(groupId)), options, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&groupId, &samlGroupName))
+
+	return resourceGitlabGroupSamlLinkRead(d, meta)
+}
+
+func resourceGitlabGroupSamlLinkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	groupId, samlGroupName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab group %s SAML link %q", groupId, samlGroupName)
+
+	req, err := client.NewRequest(http.MethodGet, samlGroupLinkPath(groupId, samlGroupName), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	link := new(samlGroupLink)
+	resp, err := client.Do(req, link)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab group SAML link %s not found so removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group_id", groupId)
+	d.Set("saml_group_name", link.Name)
+	d.Set("access_level", accessLevel[link.AccessLevel])
+
+	return nil
+}
+
+func resourceGitlabGroupSamlLinkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	groupId, samlGroupName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab group %s SAML link %q", groupId, samlGroupName)
+
+	req, err := client.NewRequest(http.MethodDelete, samlGroupLinkPath(groupId, samlGroupName), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req, nil)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func samlGroupLinkPath(group, samlGroupName string) string {
+	return fmt.Sprintf("groups/%s/saml_group_links/%s", pathEscape(group), pathEscape(samlGroupName))
+}
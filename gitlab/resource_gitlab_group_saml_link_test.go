@@ -0,0 +1,110 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupSamlLink_basic(t *testing.T) {
+	var testSamlLink samlGroupLink
+	rInt := acctest.RandInt()
+
+	// PreCheck runs after Config so load test data here
+	testSamlLinkBytes, err := ioutil.ReadFile("testdata/resource_gitlab_group_saml_link.json")
+	if err != nil {
+		t.Fatalf("[ERROR] Failed to load test data: %s", err.Error())
+	}
+	if err := json.Unmarshal(testSamlLinkBytes, &testSamlLink); err != nil {
+		t.Fatalf("[ERROR] Failed to load test data: %s", err.Error())
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupSamlLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: testAccGitlabGroupSamlLinkSkipFunc(testSamlLink.Name),
+				Config:   testAccGitlabGroupSamlLinkConfig(rInt, testSamlLink.Name, "developer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_saml_link.foo", "saml_group_name", testSamlLink.Name),
+					resource.TestCheckResourceAttr("gitlab_group_saml_link.foo", "access_level", "developer"),
+				),
+			},
+			{
+				SkipFunc: testAccGitlabGroupSamlLinkSkipFunc(testSamlLink.Name),
+				Config:   testAccGitlabGroupSamlLinkConfig(rInt, testSamlLink.Name, "maintainer"),
+				Check:    resource.TestCheckResourceAttr("gitlab_group_saml_link.foo", "access_level", "maintainer"),
+			},
+			{
+				SkipFunc:          testAccGitlabGroupSamlLinkSkipFunc(testSamlLink.Name),
+				ResourceName:      "gitlab_group_saml_link.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// SAML group links need a SAML provider, so the tests are skipped unless the test
+// data names a SAML group of a provider configured on the test instance.
+func testAccGitlabGroupSamlLinkSkipFunc(testSamlGroupName string) func() (bool, error) {
+	return func() (bool, error) {
+		if testSamlGroupName == "default" {
+			return true, nil
+		}
+
+		return isRunningInCE()
+	}
+}
+
+func testAccCheckGitlabGroupSamlLinkDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_saml_link" {
+			continue
+		}
+
+		groupID, samlGroupName, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		req, err := conn.NewRequest("GET", samlGroupLinkPath(groupID, samlGroupName), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.Do(req, nil)
+		if err == nil {
+			return fmt.Errorf("SAML link %s still exists", rs.Primary.ID)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccGitlabGroupSamlLinkConfig(rInt int, samlGroupName, accessLevel string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name        = "foo%[1]d"
+  path        = "foo%[1]d"
+  description = "Terraform acceptance test - Group SAML Links"
+}
+
+resource "gitlab_group_saml_link" "foo" {
+  group_id        = gitlab_group.foo.id
+  saml_group_name = "%[2]s"
+  access_level    = "%[3]s"
+}
+`, rInt, samlGroupName, accessLevel)
+}
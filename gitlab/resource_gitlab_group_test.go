@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/xanzy/go-gitlab"
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 )
@@ -101,6 +102,105 @@ func TestAccGitlabGroup_import(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroup_settings(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			// Create a group with settings
+			{
+				Config: testAccGitlabGroupSettingsConfig(rInt, 1, "disabled_with_override"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group.foo", "default_branch_protection", "1"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "shared_runners_setting", "disabled_with_override"),
+				),
+			},
+			// Update the settings
+			{
+				Config: testAccGitlabGroupSettingsConfig(rInt, 0, "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group.foo", "default_branch_protection", "0"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "shared_runners_setting", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroup_enterpriseSettings(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			// The settings are rejected when planning on a known Community Edition
+			{
+				SkipFunc: func() (bool, error) {
+					isCommunity, err := isGitLabCommunityEdition(testAccProvider.Meta().(*gitlab.Client))()
+					return !isCommunity, err
+				},
+				Config:      testAccGitlabGroupEnterpriseSettingsConfig(rInt),
+				ExpectError: regexp.MustCompile(`membership_lock, prevent_forking_outside_group, ip_restriction_ranges, allowed_email_domains requires GitLab Enterprise Edition`),
+			},
+			// Create a group with the settings on the Enterprise Edition
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabGroupEnterpriseSettingsConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group.foo", "membership_lock", "true"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "prevent_forking_outside_group", "true"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "ip_restriction_ranges.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "allowed_email_domains.#", "1"),
+				),
+			},
+			{
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroup_avatar(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			// Create a group with an avatar
+			{
+				Config: testAccGitlabGroupAvatarConfig(rInt, "testdata/avatar.png"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("gitlab_group.foo", "avatar_url", regexp.MustCompile(`avatar\.png$`)),
+				),
+			},
+			// Replace the avatar
+			{
+				Config: testAccGitlabGroupAvatarConfig(rInt, "testdata/avatar-update.png"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("gitlab_group.foo", "avatar_url", regexp.MustCompile(`avatar-update\.png$`)),
+				),
+			},
+			// Remove the avatar
+			{
+				Config: testAccGitlabGroupConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group.foo", "avatar_url", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGitlabGroup_nested(t *testing.T) {
 	var group gitlab.Group
 	var group2 gitlab.Group
@@ -510,3 +610,53 @@ resource "gitlab_group" "nested_foo" {
 }
   `, rInt, rInt, rInt, rInt, rInt, rInt)
 }
+
+func testAccGitlabGroupAvatarConfig(rInt int, avatar string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name        = "foo-name-%[1]d"
+  path        = "foo-path-%[1]d"
+  description = "Terraform acceptance tests"
+  avatar      = "%[2]s"
+  avatar_hash = filesha256("%[2]s")
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+`, rInt, avatar)
+}
+
+func testAccGitlabGroupSettingsConfig(rInt, defaultBranchProtection int, sharedRunnersSetting string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%[1]d"
+  path = "foo-path-%[1]d"
+  description = "Terraform acceptance tests"
+  default_branch_protection = %[2]d
+  shared_runners_setting = "%[3]s"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+  `, rInt, defaultBranchProtection, sharedRunnersSetting)
+}
+
+func testAccGitlabGroupEnterpriseSettingsConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%[1]d"
+  path = "foo-path-%[1]d"
+  description = "Terraform acceptance tests"
+  membership_lock = true
+  prevent_forking_outside_group = true
+  ip_restriction_ranges = ["10.0.0.0/8", "192.168.1.0/24"]
+  allowed_email_domains = ["example.com"]
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+  `, rInt)
+}
@@ -1,7 +1,10 @@
 package gitlab
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	gitlab "github.com/xanzy/go-gitlab"
@@ -16,6 +19,7 @@ func resourceGitlabGroupVariable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceGitlabVariableCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"group": {
@@ -30,8 +34,20 @@ func resourceGitlabGroupVariable() *schema.Resource {
 				ValidateFunc: StringIsGitlabVariableName,
 			},
 			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_file"},
+			},
+			"value_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "value_file"},
+			},
+			"value_file_hash": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Computed:  true,
 				Sensitive: true,
 			},
 			"variable_type": {
@@ -50,6 +66,16 @@ func resourceGitlabGroupVariable() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"environment_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -59,26 +85,36 @@ func resourceGitlabGroupVariableCreate(d *schema.ResourceData, meta interface{})
 
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
-	value := d.Get("value").(string)
+	value, err := expandVariableValue(d)
+	if err != nil {
+		return err
+	}
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
-
-	options := gitlab.CreateGroupVariableOptions{
-		Key:          &key,
-		Value:        &value,
-		VariableType: variableType,
-		Protected:    &protected,
-		Masked:       &masked,
+	environmentScope := d.Get("environment_scope").(string)
+	description := d.Get("description").(string)
+
+	options := variableOptions{
+		Key:              &key,
+		Value:            &value,
+		VariableType:     variableType,
+		Protected:        &protected,
+		Masked:           &masked,
+		EnvironmentScope: &environmentScope,
+		Description:      &description,
 	}
-	log.Printf("[DEBUG] create gitlab group variable %s/%s", group, key)
 
-	_, _, err := client.GroupVariables.CreateVariable(group, &options)
+	id := strings.Join([]string{group, key, environmentScope}, ":")
+
+	log.Printf("[DEBUG] create gitlab group variable %q", id)
+
+	err = createVariable(client, groupVariablesPath(group), &options)
 	if err != nil {
-		return err
+		return augmentVariableClientError(d, err)
 	}
 
-	d.SetId(buildTwoPartID(&group, &key))
+	d.SetId(id)
 
 	return resourceGitlabGroupVariableRead(d, meta)
 }
@@ -86,24 +122,46 @@ func resourceGitlabGroupVariableCreate(d *schema.ResourceData, meta interface{})
 func resourceGitlabGroupVariableRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 
-	group, key, err := parseTwoPartID(d.Id())
-	if err != nil {
-		return err
+	var group, key, environmentScope string
+
+	// An older version of this resource used the ID format "group:key".
+	// For backwards compatibility we still support the old format, and
+	// migrate it to the current one.
+	parts := strings.SplitN(d.Id(), ":", 4)
+	switch len(parts) {
+	case 2:
+		group = parts[0]
+		key = parts[1]
+		environmentScope = d.Get("environment_scope").(string)
+		if environmentScope == "" {
+			environmentScope = "*"
+		}
+		d.SetId(strings.Join([]string{group, key, environmentScope}, ":"))
+	case 3:
+		group = parts[0]
+		key = parts[1]
+		environmentScope = parts[2]
+	default:
+		return fmt.Errorf(`Failed to parse group variable ID %q: expected format group:key or group:key:environment_scope`, d.Id())
 	}
 
-	log.Printf("[DEBUG] read gitlab group variable %s/%s", group, key)
+	log.Printf("[DEBUG] read gitlab group variable %q", d.Id())
 
-	v, _, err := client.GroupVariables.GetVariable(group, key)
+	v, err := findVariable(client, groupVariablesPath(group), key, environmentScope)
 	if err != nil {
+		if errors.Is(err, errVariableNotExist) {
+			log.Printf("[DEBUG] read gitlab group variable %q was not found", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
-	d.Set("key", v.Key)
-	d.Set("value", v.Value)
-	d.Set("variable_type", v.VariableType)
 	d.Set("group", group)
-	d.Set("protected", v.Protected)
-	d.Set("masked", v.Masked)
+	for k, v := range flattenVariable(v) {
+		d.Set(k, v)
+	}
+	setVariableValueFileHash(d, v.Value)
 	return nil
 }
 
@@ -112,22 +170,28 @@ func resourceGitlabGroupVariableUpdate(d *schema.ResourceData, meta interface{})
 
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
-	value := d.Get("value").(string)
+	value, err := expandVariableValue(d)
+	if err != nil {
+		return err
+	}
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
+	environmentScope := d.Get("environment_scope").(string)
+	description := d.Get("description").(string)
 
-	options := &gitlab.UpdateGroupVariableOptions{
+	options := &variableOptions{
 		Value:        &value,
 		Protected:    &protected,
 		VariableType: variableType,
 		Masked:       &masked,
+		Description:  &description,
 	}
-	log.Printf("[DEBUG] update gitlab group variable %s/%s", group, key)
+	log.Printf("[DEBUG] update gitlab group variable %q", d.Id())
 
-	_, _, err := client.GroupVariables.UpdateVariable(group, key, options)
+	err = updateVariable(client, groupVariablesPath(group), key, options, withEnvironmentScopeFilter(environmentScope))
 	if err != nil {
-		return err
+		return augmentVariableClientError(d, err)
 	}
 	return resourceGitlabGroupVariableRead(d, meta)
 }
@@ -136,8 +200,13 @@ func resourceGitlabGroupVariableDelete(d *schema.ResourceData, meta interface{})
 	client := meta.(*gitlab.Client)
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
-	log.Printf("[DEBUG] Delete gitlab group variable %s/%s", group, key)
+	environmentScope := d.Get("environment_scope").(string)
+	log.Printf("[DEBUG] Delete gitlab group variable %q", d.Id())
 
-	_, err := client.GroupVariables.RemoveVariable(group, key)
+	_, err := client.GroupVariables.RemoveVariable(group, key, withEnvironmentScopeFilter(environmentScope))
 	return err
 }
+
+func groupVariablesPath(group string) string {
+	return fmt.Sprintf("groups/%s/variables", pathEscape(group))
+}
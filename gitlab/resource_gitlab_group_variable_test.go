@@ -11,7 +11,7 @@ import (
 )
 
 func TestAccGitlabGroupVariable_basic(t *testing.T) {
-	var groupVariable gitlab.GroupVariable
+	var groupVariable variable
 	rString := acctest.RandString(5)
 
 	resource.Test(t, resource.TestCase{
@@ -36,9 +36,10 @@ func TestAccGitlabGroupVariable_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGitlabGroupVariableExists("gitlab_group_variable.foo", &groupVariable),
 					testAccCheckGitlabGroupVariableAttributes(&groupVariable, &testAccGitlabGroupVariableExpectedAttributes{
-						Key:       fmt.Sprintf("key_%s", rString),
-						Value:     fmt.Sprintf("value-inverse-%s", rString),
-						Protected: true,
+						Key:         fmt.Sprintf("key_%s", rString),
+						Value:       fmt.Sprintf("value-inverse-%s", rString),
+						Protected:   true,
+						Description: "Updated description",
 					}),
 				),
 			},
@@ -54,11 +55,72 @@ func TestAccGitlabGroupVariable_basic(t *testing.T) {
 					}),
 				),
 			},
+			// Import the variable with the legacy ID format, which is migrated to the current one
+			{
+				ResourceName:      "gitlab_group_variable.foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGitlabGroupVariableLegacyID("gitlab_group_variable.foo"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccGitlabGroupVariableLegacyID returns the "group:key" ID an older version
+// of the resource used for the variable n.
+func testAccGitlabGroupVariableLegacyID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not Found: %s", n)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["group"], rs.Primary.Attributes["key"]), nil
+	}
+}
+
+func TestAccGitlabGroupVariable_scope(t *testing.T) {
+	rString := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupVariableDestroy,
+		Steps: []resource.TestStep{
+			// Create two variables with the same key in different environment scopes
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabGroupVariableScopeConfig(rString, "prod", "review/*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_variable.a", "environment_scope", "prod"),
+					resource.TestCheckResourceAttr("gitlab_group_variable.a", "value", "value-a"),
+					resource.TestCheckResourceAttr("gitlab_group_variable.b", "environment_scope", "review/*"),
+					resource.TestCheckResourceAttr("gitlab_group_variable.b", "value", "value-b"),
+				),
+			},
+			// Change the scope of one of the variables
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabGroupVariableScopeConfig(rString, "prod", "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_variable.a", "environment_scope", "prod"),
+					resource.TestCheckResourceAttr("gitlab_group_variable.a", "value", "value-a"),
+					resource.TestCheckResourceAttr("gitlab_group_variable.b", "environment_scope", "staging"),
+					resource.TestCheckResourceAttr("gitlab_group_variable.b", "value", "value-b"),
+				),
+			},
+			// Import one of the variables by its group, key and scope
+			{
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_group_variable.b",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
-func testAccCheckGitlabGroupVariableExists(n string, groupVariable *gitlab.GroupVariable) resource.TestCheckFunc {
+func testAccCheckGitlabGroupVariableExists(n string, groupVariable *variable) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -75,7 +137,7 @@ func testAccCheckGitlabGroupVariableExists(n string, groupVariable *gitlab.Group
 		}
 		conn := testAccProvider.Meta().(*gitlab.Client)
 
-		gotVariable, _, err := conn.GroupVariables.GetVariable(repoName, key)
+		gotVariable, err := findVariable(conn, groupVariablesPath(repoName), key, rs.Primary.Attributes["environment_scope"])
 		if err != nil {
 			return err
 		}
@@ -85,13 +147,14 @@ func testAccCheckGitlabGroupVariableExists(n string, groupVariable *gitlab.Group
 }
 
 type testAccGitlabGroupVariableExpectedAttributes struct {
-	Key       string
-	Value     string
-	Protected bool
-	Masked    bool
+	Key         string
+	Value       string
+	Protected   bool
+	Masked      bool
+	Description string
 }
 
-func testAccCheckGitlabGroupVariableAttributes(variable *gitlab.GroupVariable, want *testAccGitlabGroupVariableExpectedAttributes) resource.TestCheckFunc {
+func testAccCheckGitlabGroupVariableAttributes(variable *variable, want *testAccGitlabGroupVariableExpectedAttributes) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if variable.Key != want.Key {
 			return fmt.Errorf("got key %s; want %s", variable.Key, want.Key)
@@ -109,6 +172,10 @@ func testAccCheckGitlabGroupVariableAttributes(variable *gitlab.GroupVariable, w
 			return fmt.Errorf("got masked %t; want %t", variable.Masked, want.Masked)
 		}
 
+		if variable.Description != want.Description {
+			return fmt.Errorf("got description %q; want %q", variable.Description, want.Description)
+		}
+
 		return nil
 	}
 }
@@ -167,6 +234,30 @@ resource "gitlab_group_variable" "foo" {
   value = "value-inverse-%s"
   protected = true
   masked = false
+  description = "Updated description"
 }
 	`, rString, rString, rString, rString)
 }
+
+func testAccGitlabGroupVariableScopeConfig(rString, scopeA, scopeB string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo%[1]s"
+  path = "foo%[1]s"
+}
+
+resource "gitlab_group_variable" "a" {
+  group             = "${gitlab_group.foo.id}"
+  key               = "key_%[1]s"
+  value             = "value-a"
+  environment_scope = "%[2]s"
+}
+
+resource "gitlab_group_variable" "b" {
+  group             = "${gitlab_group.foo.id}"
+  key               = "key_%[1]s"
+  value             = "value-b"
+  environment_scope = "%[3]s"
+}
+	`, rString, scopeA, scopeB)
+}
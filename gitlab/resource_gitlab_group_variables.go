@@ -0,0 +1,71 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupVariables() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupVariablesCreate,
+		Read:   resourceGitlabGroupVariablesRead,
+		Update: resourceGitlabGroupVariablesUpdate,
+		Delete: resourceGitlabGroupVariablesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceGitlabVariablesCustomizeDiff,
+		Schema:        variablesSchema("group"),
+	}
+}
+
+func resourceGitlabGroupVariablesCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("group").(string))
+	return resourceGitlabGroupVariablesUpdate(d, meta)
+}
+
+func resourceGitlabGroupVariablesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] read gitlab group %s variables", group)
+
+	variables, err := listVariables(client, groupVariablesPath(group))
+	if err != nil {
+		return err
+	}
+
+	values, hashes := flattenVariables(variables, d)
+	d.Set("group", group)
+	if err := d.Set("variable", values); err != nil {
+		return fmt.Errorf("error setting variables: %w", err)
+	}
+	d.Set("value_file_hashes", hashes)
+
+	return nil
+}
+
+func resourceGitlabGroupVariablesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] update gitlab group %s variables", group)
+
+	if err := syncVariables(client, groupVariablesPath(group), d); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupVariablesRead(d, meta)
+}
+
+func resourceGitlabGroupVariablesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab group %s variables", group)
+
+	return deleteDeclaredVariables(client, groupVariablesPath(group), d)
+}
@@ -0,0 +1,82 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccGitlabGroupVariables_basic(t *testing.T) {
+	rString := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupVariableDestroy,
+		Steps: []resource.TestStep{
+			// Create two variables.
+			{
+				Config: testAccGitlabGroupVariablesConfig(rString, `
+  variable {
+    key   = "key_a"
+    value = "value_a"
+  }
+
+  variable {
+    key    = "key_b"
+    value  = "value_b_masked"
+    masked = true
+  }
+`),
+				Check: testAccCheckGitlabGroupVariablesKeys("gitlab_group_variables.foo", "key_a:*", "key_b:*"),
+			},
+			// Update one variable and remove the other.
+			{
+				Config: testAccGitlabGroupVariablesConfig(rString, `
+  variable {
+    key       = "key_a"
+    value     = "value_a_2"
+    protected = true
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupVariablesKeys("gitlab_group_variables.foo", "key_a:*"),
+					resource.TestCheckResourceAttr("gitlab_group_variables.foo", "variable.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_variables.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupVariablesKeys(n string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		return testAccCheckGitlabVariablesKeys(groupVariablesPath(rs.Primary.ID), want...)(s)
+	}
+}
+
+func testAccGitlabGroupVariablesConfig(rString string, variables string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo%[1]s"
+  path = "foo%[1]s"
+}
+
+resource "gitlab_group_variables" "foo" {
+  group = "${gitlab_group.foo.id}"
+%[2]s
+}
+`, rString, variables)
+}
@@ -17,6 +17,7 @@ func resourceGitlabInstanceVariable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceGitlabVariableCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"key": {
@@ -26,8 +27,20 @@ func resourceGitlabInstanceVariable() *schema.Resource {
 				ValidateFunc: StringIsGitlabVariableName,
 			},
 			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_file"},
+			},
+			"value_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "value_file"},
+			},
+			"value_file_hash": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Computed:  true,
 				Sensitive: true,
 			},
 			"variable_type": {
@@ -46,6 +59,10 @@ func resourceGitlabInstanceVariable() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -54,21 +71,26 @@ func resourceGitlabInstanceVariableCreate(d *schema.ResourceData, meta interface
 	client := meta.(*gitlab.Client)
 
 	key := d.Get("key").(string)
-	value := d.Get("value").(string)
+	value, err := expandVariableValue(d)
+	if err != nil {
+		return err
+	}
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
+	description := d.Get("description").(string)
 
-	options := gitlab.CreateInstanceVariableOptions{
+	options := variableOptions{
 		Key:          &key,
 		Value:        &value,
 		VariableType: variableType,
 		Protected:    &protected,
 		Masked:       &masked,
+		Description:  &description,
 	}
 	log.Printf("[DEBUG] create gitlab instance level CI variable %s", key)
 
-	_, _, err := client.InstanceVariables.CreateVariable(&options)
+	err = createVariable(client, instanceVariablesPath, &options)
 	if err != nil {
 		return err
 	}
@@ -85,9 +107,9 @@ func resourceGitlabInstanceVariableRead(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[DEBUG] read gitlab instance level CI variable %s", key)
 
-	v, resp, err := client.InstanceVariables.GetVariable(key)
+	v, resp, err := getVariable(client, instanceVariablesPath, key)
 	if err != nil {
-		if resp.StatusCode == http.StatusNotFound {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			log.Printf("[DEBUG] gitlab instance level CI variable for %s not found so removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -97,9 +119,11 @@ func resourceGitlabInstanceVariableRead(d *schema.ResourceData, meta interface{}
 
 	d.Set("key", v.Key)
 	d.Set("value", v.Value)
+	setVariableValueFileHash(d, v.Value)
 	d.Set("variable_type", v.VariableType)
 	d.Set("protected", v.Protected)
 	d.Set("masked", v.Masked)
+	d.Set("description", v.Description)
 	return nil
 }
 
@@ -107,20 +131,25 @@ func resourceGitlabInstanceVariableUpdate(d *schema.ResourceData, meta interface
 	client := meta.(*gitlab.Client)
 
 	key := d.Get("key").(string)
-	value := d.Get("value").(string)
+	value, err := expandVariableValue(d)
+	if err != nil {
+		return err
+	}
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
+	description := d.Get("description").(string)
 
-	options := &gitlab.UpdateInstanceVariableOptions{
+	options := &variableOptions{
 		Value:        &value,
 		Protected:    &protected,
 		VariableType: variableType,
 		Masked:       &masked,
+		Description:  &description,
 	}
 	log.Printf("[DEBUG] update gitlab instance level CI variable %s", key)
 
-	_, _, err := client.InstanceVariables.UpdateVariable(key, options)
+	err = updateVariable(client, instanceVariablesPath, key, options)
 	if err != nil {
 		return err
 	}
@@ -135,3 +164,6 @@ func resourceGitlabInstanceVariableDelete(d *schema.ResourceData, meta interface
 	_, err := client.InstanceVariables.RemoveVariable(key)
 	return err
 }
+
+// instanceVariablesPath is the API path of the instance level CI variables.
+const instanceVariablesPath = "admin/ci/variables"
@@ -39,6 +39,7 @@ func TestAccGitlabInstanceVariable_basic(t *testing.T) {
 						Value:     fmt.Sprintf("value-inverse-%s", rString),
 						Protected: true,
 					}),
+					resource.TestCheckResourceAttr("gitlab_instance_variable.foo", "description", "Updated description"),
 				),
 			},
 			// Update the instance variable to toggle the options back
@@ -126,6 +127,7 @@ resource "gitlab_instance_variable" "foo" {
   value = "value-inverse-%s"
   protected = true
   masked = false
+  description = "Updated description"
 }
 	`, rString, rString)
 }
@@ -1,9 +1,14 @@
 package gitlab
 
 import (
+	"fmt"
 	"log"
+	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	gitlab "github.com/xanzy/go-gitlab"
 )
 
@@ -13,6 +18,9 @@ func resourceGitlabLabel() *schema.Resource {
 		Read:   resourceGitlabLabelRead,
 		Update: resourceGitlabLabelUpdate,
 		Delete: resourceGitlabLabelDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGitlabLabelImporter,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project": {
@@ -25,13 +33,29 @@ func resourceGitlabLabel() *schema.Resource {
 				ForceNew: true,
 			},
 			"color": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateLabelColorFunc,
+				DiffSuppressFunc: suppressEquivalentLabelColor,
 			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"subscribed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"promote_to_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -57,6 +81,23 @@ func resourceGitlabLabelCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(label.Name)
 
+	if _, ok := d.GetOkExists("priority"); ok {
+		if err := setLabelPriority(client, project, d); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("subscribed").(bool) {
+		if _, _, err := client.Labels.SubscribeToLabel(project, label.ID); err != nil {
+			return fmt.Errorf("failed to subscribe to label %q: %w", label.Name, err)
+		}
+	}
+
+	if d.Get("promote_to_group").(bool) {
+		// The label belongs to the group now, so the next read removes it from state.
+		return promoteLabel(client, project, label.ID)
+	}
+
 	return resourceGitlabLabelRead(d, meta)
 }
 
@@ -75,9 +116,16 @@ func resourceGitlabLabelRead(d *schema.ResourceData, meta interface{}) error {
 		}
 		for _, label := range labels {
 			if label.Name == labelName {
+				if !label.IsProjectLabel {
+					log.Printf("[DEBUG] gitlab label %s/%s was promoted to a group label so removing it from state", project, labelName)
+					d.SetId("")
+					return nil
+				}
 				d.Set("description", label.Description)
 				d.Set("color", label.Color)
 				d.Set("name", label.Name)
+				d.Set("priority", label.Priority)
+				d.Set("subscribed", label.Subscribed)
 				return nil
 			}
 		}
@@ -93,6 +141,7 @@ func resourceGitlabLabelRead(d *schema.ResourceData, meta interface{}) error {
 func resourceGitlabLabelUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 	project := d.Get("project").(string)
+
 	options := &gitlab.UpdateLabelOptions{
 		Name:  gitlab.String(d.Get("name").(string)),
 		Color: gitlab.String(d.Get("color").(string)),
@@ -104,17 +153,46 @@ func resourceGitlabLabelUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] update gitlab label %s", d.Id())
 
-	_, _, err := client.Labels.UpdateLabel(project, options)
+	label, _, err := client.Labels.UpdateLabel(project, options)
 	if err != nil {
 		return err
 	}
 
+	if d.HasChange("priority") {
+		if err := setLabelPriority(client, project, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("subscribed") {
+		if d.Get("subscribed").(bool) {
+			_, _, err = client.Labels.SubscribeToLabel(project, label.ID)
+		} else {
+			_, err = client.Labels.UnsubscribeFromLabel(project, label.ID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to change subscription to label %q: %w", label.Name, err)
+		}
+	}
+
+	if d.HasChange("promote_to_group") && d.Get("promote_to_group").(bool) {
+		// The label belongs to the group now, so the next read removes it from state.
+		return promoteLabel(client, project, label.ID)
+	}
+
 	return resourceGitlabLabelRead(d, meta)
 }
 
 func resourceGitlabLabelDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 	project := d.Get("project").(string)
+
+	if d.Get("promote_to_group").(bool) {
+		// The label was promoted since the last read and is left for gitlab_group_label to manage.
+		log.Printf("[DEBUG] gitlab label %s was promoted to a group label, not deleting it", d.Id())
+		return nil
+	}
+
 	log.Printf("[DEBUG] Delete gitlab label %s", d.Id())
 	options := &gitlab.DeleteLabelOptions{
 		Name: gitlab.String(d.Id()),
@@ -123,3 +201,238 @@ func resourceGitlabLabelDelete(d *schema.ResourceData, meta interface{}) error {
 	_, err := client.Labels.DeleteLabel(project, options)
 	return err
 }
+
+func resourceGitlabLabelImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid label id (should be <project ID>:<label name>): %s", d.Id())
+	}
+
+	d.SetId(parts[1])
+	if err := d.Set("project", parts[0]); err != nil {
+		return nil, err
+	}
+
+	err := resourceGitlabLabelRead(d, meta)
+
+	return []*schema.ResourceData{d}, err
+}
+
+// setLabelPriority sets the priority of the label, or removes it when it isn't configured.
+// go-gitlab has no priority option yet, so this needs a raw request.
+func setLabelPriority(client *gitlab.Client, project string, d *schema.ResourceData) error {
+	options := struct {
+		Name     *string `json:"name"`
+		Priority *int    `json:"priority"`
+	}{
+		Name: gitlab.String(d.Id()),
+	}
+
+	// 0 is the highest priority, so it must be told apart from no priority.
+	if v, ok := d.GetOkExists("priority"); ok {
+		options.Priority = gitlab.Int(v.(int))
+	}
+
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("projects/%s/labels", pathEscape(project)), options, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		return fmt.Errorf("failed to set priority of label %q: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func promoteLabel(client *gitlab.Client, project string, labelID int) error {
+	log.Printf("[DEBUG] promote gitlab label %d of project %s to a group label", labelID, project)
+
+	if _, err := client.Labels.PromoteLabel(project, labelID); err != nil {
+		return fmt.Errorf("failed to promote label %d to a group label: %w", labelID, err)
+	}
+
+	return nil
+}
+
+// cssColorNames maps the CSS color keywords, which GitLab accepts for label colors,
+// to their hex notation.
+var cssColorNames = map[string]string{
+	"aliceblue":            "#f0f8ff",
+	"antiquewhite":         "#faebd7",
+	"aqua":                 "#00ffff",
+	"aquamarine":           "#7fffd4",
+	"azure":                "#f0ffff",
+	"beige":                "#f5f5dc",
+	"bisque":               "#ffe4c4",
+	"black":                "#000000",
+	"blanchedalmond":       "#ffebcd",
+	"blue":                 "#0000ff",
+	"blueviolet":           "#8a2be2",
+	"brown":                "#a52a2a",
+	"burlywood":            "#deb887",
+	"cadetblue":            "#5f9ea0",
+	"chartreuse":           "#7fff00",
+	"chocolate":            "#d2691e",
+	"coral":                "#ff7f50",
+	"cornflowerblue":       "#6495ed",
+	"cornsilk":             "#fff8dc",
+	"crimson":              "#dc143c",
+	"cyan":                 "#00ffff",
+	"darkblue":             "#00008b",
+	"darkcyan":             "#008b8b",
+	"darkgoldenrod":        "#b8860b",
+	"darkgray":             "#a9a9a9",
+	"darkgreen":            "#006400",
+	"darkgrey":             "#a9a9a9",
+	"darkkhaki":            "#bdb76b",
+	"darkmagenta":          "#8b008b",
+	"darkolivegreen":       "#556b2f",
+	"darkorange":           "#ff8c00",
+	"darkorchid":           "#9932cc",
+	"darkred":              "#8b0000",
+	"darksalmon":           "#e9967a",
+	"darkseagreen":         "#8fbc8f",
+	"darkslateblue":        "#483d8b",
+	"darkslategray":        "#2f4f4f",
+	"darkslategrey":        "#2f4f4f",
+	"darkturquoise":        "#00ced1",
+	"darkviolet":           "#9400d3",
+	"deeppink":             "#ff1493",
+	"deepskyblue":          "#00bfff",
+	"dimgray":              "#696969",
+	"dimgrey":              "#696969",
+	"dodgerblue":           "#1e90ff",
+	"firebrick":            "#b22222",
+	"floralwhite":          "#fffaf0",
+	"forestgreen":          "#228b22",
+	"fuchsia":              "#ff00ff",
+	"gainsboro":            "#dcdcdc",
+	"ghostwhite":           "#f8f8ff",
+	"gold":                 "#ffd700",
+	"goldenrod":            "#daa520",
+	"gray":                 "#808080",
+	"green":                "#008000",
+	"greenyellow":          "#adff2f",
+	"grey":                 "#808080",
+	"honeydew":             "#f0fff0",
+	"hotpink":              "#ff69b4",
+	"indianred":            "#cd5c5c",
+	"indigo":               "#4b0082",
+	"ivory":                "#fffff0",
+	"khaki":                "#f0e68c",
+	"lavender":             "#e6e6fa",
+	"lavenderblush":        "#fff0f5",
+	"lawngreen":            "#7cfc00",
+	"lemonchiffon":         "#fffacd",
+	"lightblue":            "#add8e6",
+	"lightcoral":           "#f08080",
+	"lightcyan":            "#e0ffff",
+	"lightgoldenrodyellow": "#fafad2",
+	"lightgray":            "#d3d3d3",
+	"lightgreen":           "#90ee90",
+	"lightgrey":            "#d3d3d3",
+	"lightpink":            "#ffb6c1",
+	"lightsalmon":          "#ffa07a",
+	"lightseagreen":        "#20b2aa",
+	"lightskyblue":         "#87cefa",
+	"lightslategray":       "#778899",
+	"lightslategrey":       "#778899",
+	"lightsteelblue":       "#b0c4de",
+	"lightyellow":          "#ffffe0",
+	"lime":                 "#00ff00",
+	"limegreen":            "#32cd32",
+	"linen":                "#faf0e6",
+	"magenta":              "#ff00ff",
+	"maroon":               "#800000",
+	"mediumaquamarine":     "#66cdaa",
+	"mediumblue":           "#0000cd",
+	"mediumorchid":         "#ba55d3",
+	"mediumpurple":         "#9370db",
+	"mediumseagreen":       "#3cb371",
+	"mediumslateblue":      "#7b68ee",
+	"mediumspringgreen":    "#00fa9a",
+	"mediumturquoise":      "#48d1cc",
+	"mediumvioletred":      "#c71585",
+	"midnightblue":         "#191970",
+	"mintcream":            "#f5fffa",
+	"mistyrose":            "#ffe4e1",
+	"moccasin":             "#ffe4b5",
+	"navajowhite":          "#ffdead",
+	"navy":                 "#000080",
+	"oldlace":              "#fdf5e6",
+	"olive":                "#808000",
+	"olivedrab":            "#6b8e23",
+	"orange":               "#ffa500",
+	"orangered":            "#ff4500",
+	"orchid":               "#da70d6",
+	"palegoldenrod":        "#eee8aa",
+	"palegreen":            "#98fb98",
+	"paleturquoise":        "#afeeee",
+	"palevioletred":        "#db7093",
+	"papayawhip":           "#ffefd5",
+	"peachpuff":            "#ffdab9",
+	"peru":                 "#cd853f",
+	"pink":                 "#ffc0cb",
+	"plum":                 "#dda0dd",
+	"powderblue":           "#b0e0e6",
+	"purple":               "#800080",
+	"rebeccapurple":        "#663399",
+	"red":                  "#ff0000",
+	"rosybrown":            "#bc8f8f",
+	"royalblue":            "#4169e1",
+	"saddlebrown":          "#8b4513",
+	"salmon":               "#fa8072",
+	"sandybrown":           "#f4a460",
+	"seagreen":             "#2e8b57",
+	"seashell":             "#fff5ee",
+	"sienna":               "#a0522d",
+	"silver":               "#c0c0c0",
+	"skyblue":              "#87ceeb",
+	"slateblue":            "#6a5acd",
+	"slategray":            "#708090",
+	"slategrey":            "#708090",
+	"snow":                 "#fffafa",
+	"springgreen":          "#00ff7f",
+	"steelblue":            "#4682b4",
+	"tan":                  "#d2b48c",
+	"teal":                 "#008080",
+	"thistle":              "#d8bfd8",
+	"tomato":               "#ff6347",
+	"turquoise":            "#40e0d0",
+	"violet":               "#ee82ee",
+	"wheat":                "#f5deb3",
+	"white":                "#ffffff",
+	"whitesmoke":           "#f5f5f5",
+	"yellow":               "#ffff00",
+	"yellowgreen":          "#9acd32",
+}
+
+var labelColorRegexp = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}){1,2}$`)
+
+// normalizeLabelColor returns the lowercase 6-digit hex notation of a label color
+// given as hex or as CSS color name, or the color as is if it is neither.
+func normalizeLabelColor(color string) string {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if hex, ok := cssColorNames[color]; ok {
+		return hex
+	}
+	if labelColorRegexp.MatchString(color) && len(color) == 4 {
+		return "#" + strings.Repeat(color[1:2], 2) + strings.Repeat(color[2:3], 2) + strings.Repeat(color[3:4], 2)
+	}
+	return color
+}
+
+var validateLabelColorFunc = func(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+	if _, ok := cssColorNames[strings.ToLower(value)]; !ok && !labelColorRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s is not a valid color for %s, use hex notation like #FFAABB or a CSS color name", value, k))
+	}
+	return
+}
+
+// suppressEquivalentLabelColor suppresses the diff between a CSS color name in the configuration
+// and the hex notation GitLab stores it as.
+func suppressEquivalentLabelColor(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeLabelColor(old) == normalizeLabelColor(new)
+}
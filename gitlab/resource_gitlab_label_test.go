@@ -89,6 +89,110 @@ func TestAccGitlabLabel_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabLabel_settings(t *testing.T) {
+	var label gitlab.Label
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabLabelDestroy,
+		Steps: []resource.TestStep{
+			// Create a label with a CSS color name, a priority and a subscription
+			{
+				Config: testAccGitlabLabelSettingsConfig(rInt, `
+color      = "red"
+priority   = 2
+subscribed = true
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabLabelExists("gitlab_label.fixme", &label),
+					resource.TestCheckResourceAttr("gitlab_label.fixme", "priority", "2"),
+					resource.TestCheckResourceAttr("gitlab_label.fixme", "subscribed", "true"),
+				),
+			},
+			{
+				ResourceName: "gitlab_label.fixme",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["gitlab_label.fixme"]
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["project"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+				// The color is read back in hex notation.
+				ImportStateVerifyIgnore: []string{"color"},
+			},
+			// Change to the highest priority, which is 0
+			{
+				Config: testAccGitlabLabelSettingsConfig(rInt, `
+color      = "red"
+priority   = 0
+subscribed = true
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_label.fixme", "priority", "0"),
+					testAccCheckGitlabLabelPrioritized("gitlab_label.fixme", 0),
+				),
+			},
+			// Remove the priority and the subscription
+			{
+				Config: testAccGitlabLabelSettingsConfig(rInt, `
+color      = "red"
+subscribed = false
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_label.fixme", "priority", "0"),
+					resource.TestCheckResourceAttr("gitlab_label.fixme", "subscribed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabLabel_promoteToGroup(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabLabelPromoteConfig(rInt, false),
+			},
+			// Promote the label, which then is a label of the group and is removed from state
+			{
+				Config:             testAccGitlabLabelPromoteConfig(rInt, true),
+				Check:              testAccCheckGitlabLabelPromoted("gitlab_group.foo", fmt.Sprintf("FIXME-%d", rInt)),
+				ExpectNonEmptyPlan: true,
+			},
+			// Removing the promoted label from the configuration keeps the group label
+			{
+				Config: testAccGitlabLabelPromotedConfig(rInt),
+				Check:  testAccCheckGitlabLabelPromoted("gitlab_group.foo", fmt.Sprintf("FIXME-%d", rInt)),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabLabelPromoted(group string, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*gitlab.Client)
+		groupID := s.RootModule().Resources[group].Primary.ID
+
+		labels, _, err := conn.GroupLabels.ListGroupLabels(groupID, &gitlab.ListGroupLabelsOptions{PerPage: 100})
+		if err != nil {
+			return err
+		}
+		for _, label := range labels {
+			if label.Name == name {
+				return nil
+			}
+		}
+		return fmt.Errorf("group label %q does not exist", name)
+	}
+}
+
 func testAccCheckGitlabLabelExists(n string, label *gitlab.Label) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -117,6 +221,32 @@ func testAccCheckGitlabLabelExists(n string, label *gitlab.Label) resource.TestC
 	}
 }
 
+// testAccCheckGitlabLabelPrioritized checks that the label has a priority. The
+// priority is read raw, as go-gitlab decodes no priority as 0.
+func testAccCheckGitlabLabelPrioritized(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		req, err := conn.NewRequest("GET", fmt.Sprintf("projects/%s/labels/%s", pathEscape(rs.Primary.Attributes["project"]), pathEscape(rs.Primary.ID)), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var label struct {
+			Priority *int `json:"priority"`
+		}
+		if _, err := conn.Do(req, &label); err != nil {
+			return err
+		}
+
+		if label.Priority == nil || *label.Priority != want {
+			return fmt.Errorf("got priority %v; want %d", label.Priority, want)
+		}
+		return nil
+	}
+}
+
 type testAccGitlabLabelExpectedAttributes struct {
 	Name        string
 	Color       string
@@ -246,3 +376,72 @@ resource "gitlab_label" "fixme" {
 }
 	`, rInt)
 }
+
+func testAccGitlabLabelSettingsConfig(rInt int, attributes string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_label" "fixme" {
+  project = gitlab_project.foo.id
+  name = "FIXME-%[1]d"
+%[2]s
+}
+	`, rInt, attributes)
+}
+
+func testAccGitlabLabelPromoteConfig(rInt int, promote bool) string {
+	return fmt.Sprintf(`%[1]s
+resource "gitlab_label" "fixme" {
+  project = gitlab_project.foo.id
+  name = "FIXME-%[2]d"
+  color = "#ffcc00"
+  promote_to_group = %[3]t
+}
+	`, testAccGitlabLabelPromotedConfig(rInt), rInt, promote)
+}
+
+func testAccGitlabLabelPromotedConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%[1]d"
+  path = "foo-path-%[1]d"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_project" "foo" {
+  name = "foo-%[1]d"
+  namespace_id = gitlab_group.foo.id
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+	`, rInt)
+}
+
+func TestNormalizeLabelColor(t *testing.T) {
+	for _, tc := range []struct {
+		color string
+		want  string
+	}{
+		{"red", "#ff0000"},
+		{"RebeccaPurple", "#663399"},
+		{"#FFCC00", "#ffcc00"},
+		{"#fc0", "#ffcc00"},
+		{"not-a-color", "not-a-color"},
+	} {
+		if got := normalizeLabelColor(tc.color); got != tc.want {
+			t.Errorf("normalizeLabelColor(%q) = %q; want %q", tc.color, got, tc.want)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -200,53 +201,7 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"author_email_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"branch_name_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"commit_message_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"commit_message_negative_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"file_name_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"commit_committer_check": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"deny_delete_tag": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"member_check": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"prevent_secrets": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"reject_unsigned_commits": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"max_file_size": {
-					Type:         schema.TypeInt,
-					Optional:     true,
-					ValidateFunc: validation.IntAtLeast(0),
-				},
-			},
+			Schema: projectPushRulesSchema(),
 		},
 	},
 	"template_name": {
@@ -295,6 +250,101 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Optional: true,
 		Default:  false,
 	},
+	"ci_config_path": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"build_timeout": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(600),
+	},
+	"auto_devops_enabled": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+	},
+	"auto_cancel_pending_pipelines": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+	},
+	"squash_option": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"never", "always", "default_on", "default_off"}, false),
+	},
+	"suggestion_commit_message": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"merge_pipelines_enabled": {
+		Type:     schema.TypeBool,
+		Optional: true,
+	},
+	"merge_trains_enabled": {
+		Type:     schema.TypeBool,
+		Optional: true,
+	},
+	"issues_access_level": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"disabled", "private", "enabled"}, false),
+	},
+	"repository_access_level": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"disabled", "private", "enabled"}, false),
+	},
+	"builds_access_level": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"disabled", "private", "enabled"}, false),
+	},
+	"wiki_access_level": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"disabled", "private", "enabled"}, false),
+	},
+	"analytics_access_level": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"disabled", "private", "enabled"}, false),
+	},
+	"operations_access_level": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"disabled", "private", "enabled"}, false),
+	},
+	"avatar": {
+		Type:        schema.TypeString,
+		Description: "A local path to the avatar image to upload.",
+		Optional:    true,
+	},
+	"avatar_hash": {
+		Type:        schema.TypeString,
+		Description: "The hash of the avatar image, e.g. filesha256(avatar). Changing it uploads the avatar again.",
+		Optional:    true,
+	},
+	"avatar_url": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"permanently_remove_on_delete": {
+		Type:        schema.TypeBool,
+		Description: "Whether a project marked for deletion by delayed project deletion should be removed immediately on destroy.",
+		Optional:    true,
+		Default:     false,
+	},
 }
 
 func resourceGitlabProject() *schema.Resource {
@@ -304,10 +354,144 @@ func resourceGitlabProject() *schema.Resource {
 		Update: resourceGitlabProjectUpdate,
 		Delete: resourceGitlabProjectDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceGitlabProjectImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
-		Schema: resourceGitLabProjectSchema,
+		Schema:        resourceGitLabProjectSchema,
+		CustomizeDiff: resourceGitlabProjectCustomizeDiff,
+	}
+}
+
+// resourceGitlabProjectImport sets the defaults of the arguments that are not read from GitLab.
+func resourceGitlabProjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("permanently_remove_on_delete", false)
+	d.Set("avatar", "")
+	d.Set("avatar_hash", "")
+	return []*schema.ResourceData{d}, nil
+}
+
+// projectSettingMinimumVersions lists the project settings that older GitLab
+// versions silently ignore, together with the version that introduced them.
+var projectSettingMinimumVersions = map[string]string{
+	"suggestion_commit_message": "13.9",
+	"analytics_access_level":    "14.0",
+	"merge_pipelines_enabled":   "14.0",
+	"merge_trains_enabled":      "14.0",
+	"squash_option":             "14.1",
+}
+
+// projectEnterpriseSettings lists the project settings that the GitLab
+// Community Edition silently ignores.
+var projectEnterpriseSettings = []string{
+	"merge_pipelines_enabled",
+	"merge_trains_enabled",
+}
+
+func resourceGitlabProjectCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	for attribute, version := range projectSettingMinimumVersions {
+		if _, ok := d.GetOk(attribute); !ok || !d.HasChange(attribute) {
+			continue
+		}
+
+		isSupported, err := isGitLabVersionAtLeast(client, version)()
+		if err != nil {
+			return err
+		}
+		if !isSupported {
+			return fmt.Errorf("%s requires GitLab %s or later", attribute, version)
+		}
+	}
+
+	var attributes []string
+	for _, attribute := range projectEnterpriseSettings {
+		if _, ok := d.GetOk(attribute); ok && d.HasChange(attribute) {
+			attributes = append(attributes, attribute)
+		}
+	}
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	isCommunity, err := isGitLabCommunityEdition(client)()
+	if err != nil {
+		return err
+	}
+	if isCommunity {
+		return fmt.Errorf("%s requires GitLab Enterprise Edition", strings.Join(attributes, ", "))
+	}
+
+	return nil
+}
+
+// projectWithSettings represents a GitLab project including the settings that
+// go-gitlab does not decode.
+type projectWithSettings struct {
+	gitlab.Project
+	BuildTimeout               int                       `json:"build_timeout"`
+	AutoDevopsEnabled          bool                      `json:"auto_devops_enabled"`
+	AutoCancelPendingPipelines string                    `json:"auto_cancel_pending_pipelines"`
+	SquashOption               string                    `json:"squash_option"`
+	SuggestionCommitMessage    string                    `json:"suggestion_commit_message"`
+	MergePipelinesEnabled      bool                      `json:"merge_pipelines_enabled"`
+	MergeTrainsEnabled         bool                      `json:"merge_trains_enabled"`
+	AnalyticsAccessLevel       gitlab.AccessControlValue `json:"analytics_access_level"`
+}
+
+// editProjectOptions represents the options to edit a project including the
+// settings that go-gitlab does not encode.
+type editProjectOptions struct {
+	gitlab.EditProjectOptions
+	SquashOption            *string                    `json:"squash_option,omitempty"`
+	SuggestionCommitMessage *string                    `json:"suggestion_commit_message,omitempty"`
+	MergePipelinesEnabled   *bool                      `json:"merge_pipelines_enabled,omitempty"`
+	MergeTrainsEnabled      *bool                      `json:"merge_trains_enabled,omitempty"`
+	AnalyticsAccessLevel    *gitlab.AccessControlValue `json:"analytics_access_level,omitempty"`
+}
+
+func getProjectWithSettings(client *gitlab.Client, project string) (*projectWithSettings, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s", pathEscape(project)), nil, nil)
+	if err != nil {
+		return nil, err
 	}
+
+	p := new(projectWithSettings)
+	if _, err := client.Do(req, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func editProject(client *gitlab.Client, project string, options *editProjectOptions) error {
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("projects/%s", pathEscape(project)), options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func resourceGitlabProjectSettingsSetToState(d *schema.ResourceData, project *projectWithSettings) {
+	d.Set("ci_config_path", project.CIConfigPath)
+	d.Set("build_timeout", project.BuildTimeout)
+	d.Set("auto_devops_enabled", project.AutoDevopsEnabled)
+	d.Set("auto_cancel_pending_pipelines", project.AutoCancelPendingPipelines)
+	d.Set("squash_option", project.SquashOption)
+	d.Set("suggestion_commit_message", project.SuggestionCommitMessage)
+	d.Set("merge_pipelines_enabled", project.MergePipelinesEnabled)
+	d.Set("merge_trains_enabled", project.MergeTrainsEnabled)
+	d.Set("issues_access_level", string(project.IssuesAccessLevel))
+	d.Set("repository_access_level", string(project.RepositoryAccessLevel))
+	d.Set("builds_access_level", string(project.BuildsAccessLevel))
+	d.Set("wiki_access_level", string(project.WikiAccessLevel))
+	d.Set("analytics_access_level", string(project.AnalyticsAccessLevel))
+	d.Set("operations_access_level", string(project.OperationsAccessLevel))
 }
 
 func resourceGitlabProjectSetToState(d *schema.ResourceData, project *gitlab.Project) {
@@ -345,6 +529,7 @@ func resourceGitlabProjectSetToState(d *schema.ResourceData, project *gitlab.Pro
 	d.Set("mirror_trigger_builds", project.MirrorTriggerBuilds)
 	d.Set("mirror_overwrites_diverged_branches", project.MirrorOverwritesDivergedBranches)
 	d.Set("only_mirror_protected_branches", project.OnlyMirrorProtectedBranches)
+	d.Set("avatar_url", project.AvatarURL)
 }
 
 func resourceGitlabProjectCreate(d *schema.ResourceData, meta interface{}) error {
@@ -420,6 +605,9 @@ func resourceGitlabProjectCreate(d *schema.ResourceData, meta interface{}) error
 
 	project, _, err := client.Projects.CreateProject(options)
 	if err != nil {
+		if pendingErr := checkProjectPathPendingDeletion(client, d); pendingErr != nil {
+			return pendingErr
+		}
 		return err
 	}
 
@@ -433,7 +621,7 @@ func resourceGitlabProjectCreate(d *schema.ResourceData, meta interface{}) error
 		stateConf := &resource.StateChangeConf{
 			Pending: []string{"scheduled", "started"},
 			Target:  []string{"finished"},
-			Timeout: 10 * time.Minute,
+			Timeout: d.Timeout(schema.TimeoutCreate),
 			Refresh: func() (interface{}, string, error) {
 				status, _, err := client.ProjectImportExport.ImportStatus(d.Id())
 				if err != nil {
@@ -457,7 +645,7 @@ func resourceGitlabProjectCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if _, ok := d.GetOk("push_rules"); ok {
-		err := editOrAddPushRules(client, d.Id(), d)
+		err := editOrAddPushRules(client, d.Id(), d, "push_rules.0.")
 		var httpError *gitlab.ErrorResponse
 		if errors.As(err, &httpError) && httpError.Response.StatusCode == http.StatusNotFound {
 			log.Printf("[DEBUG] Failed to edit push rules for project %q: %v", d.Id(), err)
@@ -468,9 +656,17 @@ func resourceGitlabProjectCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if v, ok := d.GetOk("avatar"); ok {
+		if err := updateAvatar(client, fmt.Sprintf("projects/%d", project.ID), v.(string)); err != nil {
+			return fmt.Errorf("new project %q avatar could not be uploaded: %w", d.Id(), err)
+		}
+	}
+
 	// Some project settings can't be set in the Project Create API and have to
 	// set in a second call after project creation.
-	resourceGitlabProjectUpdate(d, meta)
+	if err := resourceGitlabProjectUpdate(d, meta); err != nil {
+		return fmt.Errorf("new project %q settings could not be updated: %w", d.Id(), err)
+	}
 
 	return resourceGitlabProjectRead(d, meta)
 }
@@ -479,7 +675,7 @@ func resourceGitlabProjectRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 	log.Printf("[DEBUG] read gitlab project %s", d.Id())
 
-	project, _, err := client.Projects.GetProject(d.Id(), nil)
+	project, err := getProjectWithSettings(client, d.Id())
 	if err != nil {
 		return err
 	}
@@ -489,7 +685,8 @@ func resourceGitlabProjectRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	resourceGitlabProjectSetToState(d, project)
+	resourceGitlabProjectSetToState(d, &project.Project)
+	resourceGitlabProjectSettingsSetToState(d, project)
 
 	log.Printf("[DEBUG] read gitlab project %q push rules", d.Id())
 
@@ -509,7 +706,7 @@ func resourceGitlabProjectRead(d *schema.ResourceData, meta interface{}) error {
 func resourceGitlabProjectUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 
-	options := &gitlab.EditProjectOptions{}
+	options := &editProjectOptions{}
 	transferOptions := &gitlab.TransferProjectOptions{}
 
 	if d.HasChange("name") {
@@ -632,9 +829,65 @@ func resourceGitlabProjectUpdate(d *schema.ResourceData, meta interface{}) error
 		options.MirrorOverwritesDivergedBranches = gitlab.Bool(d.Get("mirror_overwrites_diverged_branches").(bool))
 	}
 
-	if *options != (gitlab.EditProjectOptions{}) {
+	if d.HasChange("ci_config_path") {
+		options.CIConfigPath = gitlab.String(d.Get("ci_config_path").(string))
+	}
+
+	if d.HasChange("build_timeout") {
+		options.BuildTimeout = gitlab.Int(d.Get("build_timeout").(int))
+	}
+
+	if d.HasChange("auto_devops_enabled") {
+		options.AutoDevopsEnabled = gitlab.Bool(d.Get("auto_devops_enabled").(bool))
+	}
+
+	if d.HasChange("auto_cancel_pending_pipelines") {
+		options.AutoCancelPendingPipelines = gitlab.String(d.Get("auto_cancel_pending_pipelines").(string))
+	}
+
+	if d.HasChange("squash_option") {
+		options.SquashOption = gitlab.String(d.Get("squash_option").(string))
+	}
+
+	if d.HasChange("suggestion_commit_message") {
+		options.SuggestionCommitMessage = gitlab.String(d.Get("suggestion_commit_message").(string))
+	}
+
+	if d.HasChange("merge_pipelines_enabled") {
+		options.MergePipelinesEnabled = gitlab.Bool(d.Get("merge_pipelines_enabled").(bool))
+	}
+
+	if d.HasChange("merge_trains_enabled") {
+		options.MergeTrainsEnabled = gitlab.Bool(d.Get("merge_trains_enabled").(bool))
+	}
+
+	if d.HasChange("issues_access_level") {
+		options.IssuesAccessLevel = stringToAccessControlValue(d.Get("issues_access_level").(string))
+	}
+
+	if d.HasChange("repository_access_level") {
+		options.RepositoryAccessLevel = stringToAccessControlValue(d.Get("repository_access_level").(string))
+	}
+
+	if d.HasChange("builds_access_level") {
+		options.BuildsAccessLevel = stringToAccessControlValue(d.Get("builds_access_level").(string))
+	}
+
+	if d.HasChange("wiki_access_level") {
+		options.WikiAccessLevel = stringToAccessControlValue(d.Get("wiki_access_level").(string))
+	}
+
+	if d.HasChange("analytics_access_level") {
+		options.AnalyticsAccessLevel = stringToAccessControlValue(d.Get("analytics_access_level").(string))
+	}
+
+	if d.HasChange("operations_access_level") {
+		options.OperationsAccessLevel = stringToAccessControlValue(d.Get("operations_access_level").(string))
+	}
+
+	if *options != (editProjectOptions{}) {
 		log.Printf("[DEBUG] update gitlab project %s", d.Id())
-		_, _, err := client.Projects.EditProject(d.Id(), options)
+		err := editProject(client, d.Id(), options)
 		if err != nil {
 			return err
 		}
@@ -660,8 +913,16 @@ func resourceGitlabProjectUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	// The avatar of a new project is uploaded when creating it.
+	if !d.IsNewResource() && d.HasChanges("avatar", "avatar_hash") {
+		log.Printf("[DEBUG] update gitlab project %s avatar", d.Id())
+		if err := updateAvatar(client, fmt.Sprintf("projects/%s", pathEscape(d.Id())), d.Get("avatar").(string)); err != nil {
+			return fmt.Errorf("project %q avatar could not be updated: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("push_rules") {
-		err := editOrAddPushRules(client, d.Id(), d)
+		err := editOrAddPushRules(client, d.Id(), d, "push_rules.0.")
 		var httpError *gitlab.ErrorResponse
 		if errors.As(err, &httpError) && httpError.Response.StatusCode == http.StatusNotFound {
 			log.Printf("[DEBUG] Failed to get push rules for project %q: %v", d.Id(), err)
@@ -684,6 +945,9 @@ func resourceGitlabProjectDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	permanentlyRemove := d.Get("permanently_remove_on_delete").(bool)
+	permanentlyRemoveRequested := false
+
 	// Wait for the project to be deleted.
 	// Deleting a project in gitlab is async.
 	stateConf := &resource.StateChangeConf{
@@ -692,7 +956,7 @@ func resourceGitlabProjectDelete(d *schema.ResourceData, meta interface{}) error
 		Refresh: func() (interface{}, string, error) {
 			out, response, err := client.Projects.GetProject(d.Id(), nil)
 			if err != nil {
-				if response.StatusCode == 404 {
+				if response != nil && response.StatusCode == http.StatusNotFound {
 					return out, "Deleted", nil
 				}
 				log.Printf("[ERROR] Received error: %#v", err)
@@ -700,12 +964,20 @@ func resourceGitlabProjectDelete(d *schema.ResourceData, meta interface{}) error
 			}
 			if out.MarkedForDeletionAt != nil {
 				// Represents a Gitlab EE soft-delete
-				return out, "Deleted", nil
+				if !permanentlyRemove {
+					return out, "Deleted", nil
+				}
+				if !permanentlyRemoveRequested {
+					if err := permanentlyRemoveProject(client, out); err != nil {
+						return out, "Error", err
+					}
+					permanentlyRemoveRequested = true
+				}
 			}
 			return out, "Deleting", nil
 		},
 
-		Timeout:    10 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 3 * time.Second,
 		Delay:      5 * time.Second,
 	}
@@ -717,10 +989,118 @@ func resourceGitlabProjectDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func editOrAddPushRules(client *gitlab.Client, projectID string, d *schema.ResourceData) error {
+// permanentlyRemoveProject removes a project that is already marked for deletion
+// without waiting for the delayed project deletion period to expire.
+func permanentlyRemoveProject(client *gitlab.Client, project *gitlab.Project) error {
+	isSupported, err := isGitLabVersionAtLeast(client, "15.11")()
+	if err != nil {
+		return err
+	}
+	if !isSupported {
+		return fmt.Errorf("project %q is marked for deletion and permanently removing it requires GitLab 15.11 or later", project.PathWithNamespace)
+	}
+
+	log.Printf("[DEBUG] permanently remove gitlab project %q", project.PathWithNamespace)
+
+	_, err = client.Projects.DeleteProject(project.ID, withPermanentRemoval(project.PathWithNamespace))
+	if err != nil {
+		return fmt.Errorf("project %q could not be permanently removed, check that the instance allows immediate deletion: %w", project.PathWithNamespace, err)
+	}
+	return nil
+}
+
+// checkProjectPathPendingDeletion returns an error if the path of the project to create
+// is still held by a project that is marked for deletion.
+func checkProjectPathPendingDeletion(client *gitlab.Client, d *schema.ResourceData) error {
+	path := d.Get("path").(string)
+	if path == "" {
+		path = d.Get("name").(string)
+	}
+
+	var namespace string
+	if v, ok := d.GetOk("namespace_id"); ok {
+		ns, _, err := client.Namespaces.GetNamespace(v.(int))
+		if err != nil {
+			return nil
+		}
+		namespace = ns.FullPath
+	} else {
+		user, _, err := client.Users.CurrentUser()
+		if err != nil {
+			return nil
+		}
+		namespace = user.Username
+	}
+
+	fullPath := fmt.Sprintf("%s/%s", namespace, path)
+	project, _, err := client.Projects.GetProject(fullPath, nil)
+	if err != nil || project.MarkedForDeletionAt == nil {
+		return nil
+	}
+
+	return fmt.Errorf("project path %q is still held by project %d, which is pending deletion since %s; "+
+		"wait for the deletion to complete or destroy it with permanently_remove_on_delete enabled",
+		fullPath, project.ID, project.MarkedForDeletionAt)
+}
+
+// projectPushRulesSchema returns the push rule attributes shared by the push_rules
+// block of gitlab_project and the gitlab_project_push_rules resource.
+func projectPushRulesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"author_email_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"branch_name_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"commit_message_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"commit_message_negative_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"file_name_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"commit_committer_check": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"deny_delete_tag": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"member_check": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"prevent_secrets": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"reject_unsigned_commits": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"max_file_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
+// editOrAddPushRules edits the push rules of the project, or adds them if the project has none.
+// The attributes are read from d under prefix, e.g. "push_rules.0." for the push_rules block.
+func editOrAddPushRules(client *gitlab.Client, projectID string, d *schema.ResourceData, prefix string) error {
 	log.Printf("[DEBUG] Editing push rules for project %q", projectID)
 
-	editOptions := expandEditProjectPushRuleOptions(d)
+	editOptions := expandEditProjectPushRuleOptions(d, prefix)
 	_, _, err := client.Projects.EditProjectPushRule(projectID, editOptions)
 	if err == nil {
 		return nil
@@ -736,7 +1116,7 @@ func editOrAddPushRules(client *gitlab.Client, projectID string, d *schema.Resou
 	log.Printf("[DEBUG] Failed to edit push rules for project %q: %v", projectID, err)
 	log.Printf("[DEBUG] Creating new push rules for project %q", projectID)
 
-	addOptions := expandAddProjectPushRuleOptions(d)
+	addOptions := expandAddProjectPushRuleOptions(d, prefix)
 	_, _, err = client.Projects.AddProjectPushRule(projectID, addOptions)
 	if err != nil {
 		return err
@@ -745,100 +1125,106 @@ func editOrAddPushRules(client *gitlab.Client, projectID string, d *schema.Resou
 	return nil
 }
 
-func expandEditProjectPushRuleOptions(d *schema.ResourceData) *gitlab.EditProjectPushRuleOptions {
+func expandEditProjectPushRuleOptions(d *schema.ResourceData, prefix string) *gitlab.EditProjectPushRuleOptions {
 	options := &gitlab.EditProjectPushRuleOptions{}
 
-	if d.HasChange("push_rules.0.author_email_regex") {
-		options.AuthorEmailRegex = gitlab.String(d.Get("push_rules.0.author_email_regex").(string))
+	// A new resource may take over push rules that already exist, e.g. the
+	// instance defaults, so all attributes are sent in that case.
+	changed := func(key string) bool {
+		return d.IsNewResource() || d.HasChange(prefix+key)
+	}
+
+	if changed("author_email_regex") {
+		options.AuthorEmailRegex = gitlab.String(d.Get(prefix + "author_email_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.branch_name_regex") {
-		options.BranchNameRegex = gitlab.String(d.Get("push_rules.0.branch_name_regex").(string))
+	if changed("branch_name_regex") {
+		options.BranchNameRegex = gitlab.String(d.Get(prefix + "branch_name_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.commit_message_regex") {
-		options.CommitMessageRegex = gitlab.String(d.Get("push_rules.0.commit_message_regex").(string))
+	if changed("commit_message_regex") {
+		options.CommitMessageRegex = gitlab.String(d.Get(prefix + "commit_message_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.commit_message_negative_regex") {
-		options.CommitMessageNegativeRegex = gitlab.String(d.Get("push_rules.0.commit_message_negative_regex").(string))
+	if changed("commit_message_negative_regex") {
+		options.CommitMessageNegativeRegex = gitlab.String(d.Get(prefix + "commit_message_negative_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.file_name_regex") {
-		options.FileNameRegex = gitlab.String(d.Get("push_rules.0.file_name_regex").(string))
+	if changed("file_name_regex") {
+		options.FileNameRegex = gitlab.String(d.Get(prefix + "file_name_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.commit_committer_check") {
-		options.CommitCommitterCheck = gitlab.Bool(d.Get("push_rules.0.commit_committer_check").(bool))
+	if changed("commit_committer_check") {
+		options.CommitCommitterCheck = gitlab.Bool(d.Get(prefix + "commit_committer_check").(bool))
 	}
 
-	if d.HasChange("push_rules.0.deny_delete_tag") {
-		options.DenyDeleteTag = gitlab.Bool(d.Get("push_rules.0.deny_delete_tag").(bool))
+	if changed("deny_delete_tag") {
+		options.DenyDeleteTag = gitlab.Bool(d.Get(prefix + "deny_delete_tag").(bool))
 	}
 
-	if d.HasChange("push_rules.0.member_check") {
-		options.MemberCheck = gitlab.Bool(d.Get("push_rules.0.member_check").(bool))
+	if changed("member_check") {
+		options.MemberCheck = gitlab.Bool(d.Get(prefix + "member_check").(bool))
 	}
 
-	if d.HasChange("push_rules.0.prevent_secrets") {
-		options.PreventSecrets = gitlab.Bool(d.Get("push_rules.0.prevent_secrets").(bool))
+	if changed("prevent_secrets") {
+		options.PreventSecrets = gitlab.Bool(d.Get(prefix + "prevent_secrets").(bool))
 	}
 
-	if d.HasChange("push_rules.0.reject_unsigned_commits") {
-		options.RejectUnsignedCommits = gitlab.Bool(d.Get("push_rules.0.reject_unsigned_commits").(bool))
+	if changed("reject_unsigned_commits") {
+		options.RejectUnsignedCommits = gitlab.Bool(d.Get(prefix + "reject_unsigned_commits").(bool))
 	}
 
-	if d.HasChange("push_rules.0.max_file_size") {
-		options.MaxFileSize = gitlab.Int(d.Get("push_rules.0.max_file_size").(int))
+	if changed("max_file_size") {
+		options.MaxFileSize = gitlab.Int(d.Get(prefix + "max_file_size").(int))
 	}
 
 	return options
 }
 
-func expandAddProjectPushRuleOptions(d *schema.ResourceData) *gitlab.AddProjectPushRuleOptions {
+func expandAddProjectPushRuleOptions(d *schema.ResourceData, prefix string) *gitlab.AddProjectPushRuleOptions {
 	options := &gitlab.AddProjectPushRuleOptions{}
 
-	if v, ok := d.GetOk("push_rules.0.author_email_regex"); ok {
+	if v, ok := d.GetOk(prefix + "author_email_regex"); ok {
 		options.AuthorEmailRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.branch_name_regex"); ok {
+	if v, ok := d.GetOk(prefix + "branch_name_regex"); ok {
 		options.BranchNameRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.commit_message_regex"); ok {
+	if v, ok := d.GetOk(prefix + "commit_message_regex"); ok {
 		options.CommitMessageRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.commit_message_negative_regex"); ok {
+	if v, ok := d.GetOk(prefix + "commit_message_negative_regex"); ok {
 		options.CommitMessageNegativeRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.file_name_regex"); ok {
+	if v, ok := d.GetOk(prefix + "file_name_regex"); ok {
 		options.FileNameRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.commit_committer_check"); ok {
+	if v, ok := d.GetOk(prefix + "commit_committer_check"); ok {
 		options.CommitCommitterCheck = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.deny_delete_tag"); ok {
+	if v, ok := d.GetOk(prefix + "deny_delete_tag"); ok {
 		options.DenyDeleteTag = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.member_check"); ok {
+	if v, ok := d.GetOk(prefix + "member_check"); ok {
 		options.MemberCheck = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.prevent_secrets"); ok {
+	if v, ok := d.GetOk(prefix + "prevent_secrets"); ok {
 		options.PreventSecrets = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.reject_unsigned_commits"); ok {
+	if v, ok := d.GetOk(prefix + "reject_unsigned_commits"); ok {
 		options.RejectUnsignedCommits = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.max_file_size"); ok {
+	if v, ok := d.GetOk(prefix + "max_file_size"); ok {
 		options.MaxFileSize = gitlab.Int(v.(int))
 	}
 
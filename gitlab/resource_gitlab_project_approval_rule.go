@@ -2,16 +2,28 @@ package gitlab
 
 import (
 	"errors"
+	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	gitlab "github.com/xanzy/go-gitlab"
 )
 
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#create-project-level-rule
 var errApprovalRuleNotFound = errors.New("approval rule not found")
 
+// approvalRuleTypes lists the rule types the API can create. Code owner rules are
+// created by GitLab from the CODEOWNERS file.
+var approvalRuleTypes = []string{"regular", "any_approver"}
+
+var approvalRuleScanners = []string{
+	"sast", "secret_detection", "dependency_scanning", "container_scanning",
+	"dast", "coverage_fuzzing", "api_fuzzing", "cluster_image_scanning",
+}
+
 func resourceGitlabProjectApprovalRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGitlabProjectApprovalRuleCreate,
@@ -35,6 +47,13 @@ func resourceGitlabProjectApprovalRule() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"rule_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "regular",
+				ValidateFunc: validation.StringInSlice(approvalRuleTypes, false),
+			},
 			"user_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -47,17 +66,33 @@ func resourceGitlabProjectApprovalRule() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeInt},
 				Set:      schema.HashInt,
 			},
+			"protected_branch_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Set:      schema.HashInt,
+			},
+			"scanners": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(approvalRuleScanners, false),
+				},
+				Set: schema.HashString,
+			},
+			"vulnerabilities_allowed": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 	}
 }
 
 func resourceGitlabProjectApprovalRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	options := gitlab.CreateProjectLevelRuleOptions{
-		Name:              gitlab.String(d.Get("name").(string)),
-		ApprovalsRequired: gitlab.Int(d.Get("approvals_required").(int)),
-		UserIDs:           expandApproverIds(d.Get("user_ids")),
-		GroupIDs:          expandApproverIds(d.Get("group_ids")),
-	}
+	options := expandProjectApprovalRuleOptions(d)
+	options.RuleType = gitlab.String(d.Get("rule_type").(string))
 
 	project := d.Get("project").(string)
 
@@ -65,7 +100,7 @@ func resourceGitlabProjectApprovalRuleCreate(d *schema.ResourceData, meta interf
 
 	client := meta.(*gitlab.Client)
 
-	rule, _, err := client.Projects.CreateProjectApprovalRule(project, &options)
+	rule, err := createApprovalRule(client, projectApprovalRulesPath(project), options)
 	if err != nil {
 		return err
 	}
@@ -95,14 +130,17 @@ func resourceGitlabProjectApprovalRuleRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	d.Set("name", rule.Name)
-	d.Set("approvals_required", rule.ApprovalsRequired)
+	if err := setApprovalRuleToState(d, rule); err != nil {
+		return err
+	}
 
-	if err := d.Set("group_ids", flattenApprovalRuleGroupIDs(rule.Groups)); err != nil {
+	d.Set("vulnerabilities_allowed", rule.VulnerabilitiesAllowed)
+
+	if err := d.Set("scanners", rule.Scanners); err != nil {
 		return err
 	}
 
-	if err := d.Set("user_ids", flattenApprovalRuleUserIDs(rule.Users)); err != nil {
+	if err := d.Set("protected_branch_ids", flattenApprovalRuleProtectedBranchIDs(rule.ProtectedBranches)); err != nil {
 		return err
 	}
 
@@ -120,18 +158,13 @@ func resourceGitlabProjectApprovalRuleUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	options := gitlab.UpdateProjectLevelRuleOptions{
-		Name:              gitlab.String(d.Get("name").(string)),
-		ApprovalsRequired: gitlab.Int(d.Get("approvals_required").(int)),
-		UserIDs:           expandApproverIds(d.Get("user_ids")),
-		GroupIDs:          expandApproverIds(d.Get("group_ids")),
-	}
+	options := expandProjectApprovalRuleOptions(d)
 
 	log.Printf("[DEBUG] Project %s update gitlab project-level approval rule %s", projectID, *options.Name)
 
 	client := meta.(*gitlab.Client)
 
-	_, _, err = client.Projects.UpdateProjectApprovalRule(projectID, ruleIDInt, &options)
+	_, err = updateApprovalRule(client, projectApprovalRulesPath(projectID), ruleIDInt, options)
 	if err != nil {
 		return err
 	}
@@ -163,7 +196,7 @@ func resourceGitlabProjectApprovalRuleDelete(d *schema.ResourceData, meta interf
 }
 
 // getApprovalRuleByID checks the list of rules and finds the one that matches our rule ID.
-func getApprovalRuleByID(client *gitlab.Client, id string) (*gitlab.ProjectApprovalRule, error) {
+func getApprovalRuleByID(client *gitlab.Client, id string) (*approvalRule, error) {
 	projectID, ruleID, err := parseTwoPartID(id)
 	if err != nil {
 		return nil, err
@@ -176,19 +209,141 @@ func getApprovalRuleByID(client *gitlab.Client, id string) (*gitlab.ProjectAppro
 
 	log.Printf("[DEBUG] read approval rules for project %s", projectID)
 
-	rules, _, err := client.Projects.GetProjectApprovalRules(projectID)
+	return findApprovalRule(client, projectApprovalRulesPath(projectID), ruleIDInt)
+}
+
+// approvalRule represents a project or group level approval rule, including
+// the security approval attributes that go-gitlab does not decode.
+type approvalRule struct {
+	gitlab.ProjectApprovalRule
+	Scanners               []string `json:"scanners"`
+	VulnerabilitiesAllowed int      `json:"vulnerabilities_allowed"`
+}
+
+// approvalRuleOptions represents the options to create or update a project or
+// group level approval rule.
+type approvalRuleOptions struct {
+	Name                   *string   `json:"name,omitempty"`
+	ApprovalsRequired      *int      `json:"approvals_required,omitempty"`
+	RuleType               *string   `json:"rule_type,omitempty"`
+	UserIDs                []int     `json:"user_ids"`
+	GroupIDs               []int     `json:"group_ids"`
+	ProtectedBranchIDs     *[]int    `json:"protected_branch_ids,omitempty"`
+	Scanners               *[]string `json:"scanners,omitempty"`
+	VulnerabilitiesAllowed *int      `json:"vulnerabilities_allowed,omitempty"`
+}
+
+func projectApprovalRulesPath(project string) string {
+	return fmt.Sprintf("projects/%s/approval_rules", pathEscape(project))
+}
+
+func findApprovalRule(client *gitlab.Client, path string, ruleID int) (*approvalRule, error) {
+	page := 1
+
+	for {
+		req, err := client.NewRequest(http.MethodGet, path, &gitlab.ListOptions{Page: page, PerPage: 100}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var rules []*approvalRule
+		resp, err := client.Do(req, &rules)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range rules {
+			if r.ID == ruleID {
+				log.Printf("[DEBUG] found approval rule %+v", r)
+				return r, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, errApprovalRuleNotFound
+		}
+
+		page++
+	}
+}
+
+func createApprovalRule(client *gitlab.Client, path string, options *approvalRuleOptions) (*approvalRule, error) {
+	req, err := client.NewRequest(http.MethodPost, path, options, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, r := range rules {
-		if r.ID == ruleIDInt {
-			log.Printf("[DEBUG] found project-level rule %+v", r)
-			return r, nil
-		}
+	rule := new(approvalRule)
+	if _, err := client.Do(req, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func updateApprovalRule(client *gitlab.Client, path string, ruleID int, options *approvalRuleOptions) (*approvalRule, error) {
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("%s/%d", path, ruleID), options, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := new(approvalRule)
+	if _, err := client.Do(req, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// expandApprovalRuleOptions builds the options shared by project and group
+// level approval rules from the resource data.
+func expandApprovalRuleOptions(d *schema.ResourceData) *approvalRuleOptions {
+	return &approvalRuleOptions{
+		Name:              gitlab.String(d.Get("name").(string)),
+		ApprovalsRequired: gitlab.Int(d.Get("approvals_required").(int)),
+		UserIDs:           expandApproverIds(d.Get("user_ids")),
+		GroupIDs:          expandApproverIds(d.Get("group_ids")),
+	}
+}
+
+// expandProjectApprovalRuleOptions adds the project level settings to the shared
+// options. They are sent whenever they change, including as an empty list or 0,
+// so that removing them from the configuration clears them.
+func expandProjectApprovalRuleOptions(d *schema.ResourceData) *approvalRuleOptions {
+	options := expandApprovalRuleOptions(d)
+
+	if d.HasChange("protected_branch_ids") {
+		protectedBranchIDs := expandApproverIds(d.Get("protected_branch_ids"))
+		options.ProtectedBranchIDs = &protectedBranchIDs
+	}
+
+	if d.HasChange("scanners") {
+		options.Scanners = stringSetToStringSlice(d.Get("scanners").(*schema.Set))
+	}
+
+	if d.HasChange("vulnerabilities_allowed") {
+		options.VulnerabilitiesAllowed = gitlab.Int(d.Get("vulnerabilities_allowed").(int))
+	}
+
+	return options
+}
+
+// setApprovalRuleToState stores the attributes shared by project and group
+// level approval rules in state.
+func setApprovalRuleToState(d *schema.ResourceData, rule *approvalRule) error {
+	d.Set("name", rule.Name)
+	d.Set("approvals_required", rule.ApprovalsRequired)
+	d.Set("rule_type", rule.RuleType)
+
+	if err := d.Set("group_ids", flattenApprovalRuleGroupIDs(rule.Groups)); err != nil {
+		return err
+	}
+
+	if err := d.Set("user_ids", flattenApprovalRuleUserIDs(rule.Users)); err != nil {
+		return err
 	}
 
-	return nil, errApprovalRuleNotFound
+	return nil
 }
 
 // flattenApprovalRuleUserIDs flattens a list of approval user ids into a list
@@ -215,9 +370,21 @@ func flattenApprovalRuleGroupIDs(groups []*gitlab.Group) []int {
 	return groupIDs
 }
 
+// flattenApprovalRuleProtectedBranchIDs flattens a list of protected branches
+// into a list of their ids for storage in state.
+func flattenApprovalRuleProtectedBranchIDs(protectedBranches []*gitlab.ProtectedBranch) []int {
+	var protectedBranchIDs []int
+
+	for _, protectedBranch := range protectedBranches {
+		protectedBranchIDs = append(protectedBranchIDs, protectedBranch.ID)
+	}
+
+	return protectedBranchIDs
+}
+
 // expandApproverIds Expands an interface into a list of ints to read from state.
 func expandApproverIds(ids interface{}) []int {
-	var approverIDs []int
+	approverIDs := []int{}
 
 	for _, id := range ids.(*schema.Set).List() {
 		approverIDs = append(approverIDs, id.(int))
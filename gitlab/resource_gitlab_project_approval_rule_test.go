@@ -101,6 +101,62 @@ func TestAccGitLabProjectApprovalRule_import(t *testing.T) {
 	})
 }
 
+func TestAccGitLabProjectApprovalRule_protectedBranches(t *testing.T) {
+	var projectApprovalRule gitlab.ProjectApprovalRule
+	randomInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckGitlabProjectApprovalRuleDestroy,
+		Steps: []resource.TestStep{
+			{ // Create a rule scoped to a protected branch
+				SkipFunc: isRunningInCE,
+				Config: testAccGitLabProjectApprovalRuleProtectedBranchesConfig(randomInt, `
+	protected_branch_ids    = [gitlab_branch_protection.master.branch_protection_id]
+	scanners                = ["sast", "dependency_scanning"]
+	vulnerabilities_allowed = 2
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectApprovalRuleExists("gitlab_project_approval_rule.foo", &projectApprovalRule),
+					resource.TestCheckResourceAttr("gitlab_project_approval_rule.foo", "rule_type", "regular"),
+					resource.TestCheckResourceAttr("gitlab_project_approval_rule.foo", "protected_branch_ids.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_project_approval_rule.foo", "scanners.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_approval_rule.foo", "vulnerabilities_allowed", "2"),
+					func(s *terraform.State) error {
+						if len(projectApprovalRule.ProtectedBranches) != 1 || projectApprovalRule.ProtectedBranches[0].Name != "master" {
+							return fmt.Errorf("got protected branches %v; want [master]", projectApprovalRule.ProtectedBranches)
+						}
+						return nil
+					},
+				),
+			},
+			{ // Verify Import
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_project_approval_rule.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{ // Clear the protected branches and security settings
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitLabProjectApprovalRuleProtectedBranchesConfig(randomInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectApprovalRuleExists("gitlab_project_approval_rule.foo", &projectApprovalRule),
+					resource.TestCheckResourceAttr("gitlab_project_approval_rule.foo", "protected_branch_ids.#", "0"),
+					resource.TestCheckResourceAttr("gitlab_project_approval_rule.foo", "scanners.#", "0"),
+					resource.TestCheckResourceAttr("gitlab_project_approval_rule.foo", "vulnerabilities_allowed", "0"),
+					func(s *terraform.State) error {
+						if len(projectApprovalRule.ProtectedBranches) != 0 {
+							return fmt.Errorf("got protected branches %v; want none", projectApprovalRule.ProtectedBranches)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 type testAccGitlabProjectApprovalRuleExpectedAttributes struct {
 	ApprovalsRequired int
 	ApproverUsernames []string
@@ -260,6 +316,31 @@ resource "gitlab_project_approval_rule" "foo" {
 	)
 }
 
+func testAccGitLabProjectApprovalRuleProtectedBranchesConfig(randomInt int, settings string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+	name                   = "foo project %[1]d"
+	path                   = "foo-project-%[1]d"
+	description            = "Terraform acceptance test - Approval Rule"
+	visibility_level       = "public"
+	initialize_with_readme = true
+}
+
+resource "gitlab_branch_protection" "master" {
+	project            = gitlab_project.foo.id
+	branch             = "master"
+	push_access_level  = "maintainer"
+	merge_access_level = "developer"
+}
+
+resource "gitlab_project_approval_rule" "foo" {
+	project              = gitlab_project.foo.id
+	name                 = "foo rule %[1]d"
+	approvals_required   = 1
+%[2]s}
+	`, randomInt, settings)
+}
+
 func testAccCheckGitlabProjectApprovalRuleExists(n string, projectApprovalRule *gitlab.ProjectApprovalRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,203 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectBadge() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectBadgeCreate,
+		Read:   resourceGitlabProjectBadgeRead,
+		Update: resourceGitlabProjectBadgeUpdate,
+		Delete: resourceGitlabProjectBadgeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: badgeSchema("project"),
+	}
+}
+
+// badgeSchema returns the schema of a project or group badge, with parent
+// being the attribute that holds the ID of the project or group.
+func badgeSchema(parent string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		parent: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"link_url": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"image_url": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"rendered_link_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"rendered_image_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func resourceGitlabProjectBadgeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	projectID := d.Get("project").(string)
+
+	log.Printf("[DEBUG] create gitlab project %s badge %q", projectID, d.Get("link_url").(string))
+
+	badge, err := addBadge(client, projectBadgesPath(projectID), expandBadgeOptions(d))
+	if err != nil {
+		return err
+	}
+
+	badgeID := strconv.Itoa(badge.ID)
+	d.SetId(buildTwoPartID(&projectID, &badgeID))
+
+	return resourceGitlabProjectBadgeRead(d, meta)
+}
+
+func resourceGitlabProjectBadgeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	projectID, badgeID, err := parseTwoPartIntID(d.Id(), "badge")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab project %s badge %d", projectID, badgeID)
+
+	badge, resp, err := getBadge(client, projectBadgesPath(projectID), badgeID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab project badge %s not found so removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", projectID)
+	setBadgeToState(d, badge)
+
+	return nil
+}
+
+func resourceGitlabProjectBadgeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	projectID, badgeID, err := parseTwoPartIntID(d.Id(), "badge")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] update gitlab project %s badge %d", projectID, badgeID)
+
+	if _, err := editBadge(client, projectBadgesPath(projectID), badgeID, expandBadgeOptions(d)); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectBadgeRead(d, meta)
+}
+
+func resourceGitlabProjectBadgeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	projectID, badgeID, err := parseTwoPartIntID(d.Id(), "badge")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab project %s badge %d", projectID, badgeID)
+
+	_, err = client.ProjectBadges.DeleteProjectBadge(projectID, badgeID)
+	return err
+}
+
+// badge is a project or group badge. go-gitlab doesn't know about the badge
+// name yet, so badges are read and written with raw requests.
+type badge struct {
+	gitlab.ProjectBadge
+	Name string `json:"name"`
+}
+
+type badgeOptions struct {
+	LinkURL  *string `url:"link_url,omitempty" json:"link_url,omitempty"`
+	ImageURL *string `url:"image_url,omitempty" json:"image_url,omitempty"`
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
+}
+
+func projectBadgesPath(projectID string) string {
+	return fmt.Sprintf("projects/%s/badges", pathEscape(projectID))
+}
+
+func expandBadgeOptions(d *schema.ResourceData) *badgeOptions {
+	return &badgeOptions{
+		LinkURL:  gitlab.String(d.Get("link_url").(string)),
+		ImageURL: gitlab.String(d.Get("image_url").(string)),
+		Name:     gitlab.String(d.Get("name").(string)),
+	}
+}
+
+func setBadgeToState(d *schema.ResourceData, badge *badge) {
+	d.Set("link_url", badge.LinkURL)
+	d.Set("image_url", badge.ImageURL)
+	d.Set("name", badge.Name)
+	d.Set("rendered_link_url", badge.RenderedLinkURL)
+	d.Set("rendered_image_url", badge.RenderedImageURL)
+}
+
+func getBadge(client *gitlab.Client, path string, badgeID int) (*badge, *gitlab.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%d", path, badgeID), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(badge)
+	resp, err := client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+func addBadge(client *gitlab.Client, path string, opt *badgeOptions) (*badge, error) {
+	req, err := client.NewRequest(http.MethodPost, path, opt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	b := new(badge)
+	if _, err := client.Do(req, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func editBadge(client *gitlab.Client, path string, badgeID int, opt *badgeOptions) (*badge, error) {
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("%s/%d", path, badgeID), opt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	b := new(badge)
+	if _, err := client.Do(req, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
@@ -0,0 +1,93 @@
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectBadge_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectBadgeDestroy,
+		Steps: []resource.TestStep{
+			// Create a project badge with placeholders
+			{
+				Config: testAccGitlabProjectBadgeConfig(rInt, "pipeline", "https://example.com/%%{project_path}", "https://example.com/%%{project_path}/badges/%%{default_branch}/pipeline.svg"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_badge.foo", "name", "pipeline"),
+					resource.TestCheckResourceAttr("gitlab_project_badge.foo", "link_url", "https://example.com/%{project_path}"),
+					resource.TestMatchResourceAttr("gitlab_project_badge.foo", "rendered_link_url", regexp.MustCompile(fmt.Sprintf(`^https://example\.com/.+/foo-%d$`, rInt))),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_badge.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the project badge
+			{
+				Config: testAccGitlabProjectBadgeConfig(rInt, "coverage", "https://example.com/coverage", "https://example.com/coverage.svg"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_badge.foo", "name", "coverage"),
+					resource.TestCheckResourceAttr("gitlab_project_badge.foo", "rendered_link_url", "https://example.com/coverage"),
+					resource.TestCheckResourceAttr("gitlab_project_badge.foo", "rendered_image_url", "https://example.com/coverage.svg"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectBadgeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_badge" {
+			continue
+		}
+
+		projectID, badgeID, err := parseTwoPartIntID(rs.Primary.ID, "badge")
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := getBadge(client, projectBadgesPath(projectID), badgeID)
+		if err == nil {
+			return fmt.Errorf("project badge %s still exists", rs.Primary.ID)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccGitlabProjectBadgeConfig(rInt int, name, linkURL, imageURL string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%[1]d"
+  path = "foo-%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_project_badge" "foo" {
+  project   = gitlab_project.foo.id
+  name      = "%[2]s"
+  link_url  = "%[3]s"
+  image_url = "%[4]s"
+}
+	`, rInt, name, linkURL, imageURL)
+}
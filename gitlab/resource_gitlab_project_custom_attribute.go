@@ -0,0 +1,170 @@
+package gitlab
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectCustomAttribute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectCustomAttributeCreate,
+		Read:   resourceGitlabProjectCustomAttributeRead,
+		Update: resourceGitlabProjectCustomAttributeUpdate,
+		Delete: resourceGitlabProjectCustomAttributeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: customAttributeSchema("project_id"),
+	}
+}
+
+// customAttributeSchema returns the schema of a custom attribute of a project,
+// group or user, with parent being the attribute that holds its ID.
+func customAttributeSchema(parent string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		parent: {
+			Type:     schema.TypeInt,
+			Required: true,
+			ForceNew: true,
+		},
+		"key": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+// customAttributesAPI manages the custom attributes of a project, group or user.
+type customAttributesAPI struct {
+	get    func(int, string, ...gitlab.RequestOptionFunc) (*gitlab.CustomAttribute, *gitlab.Response, error)
+	set    func(int, gitlab.CustomAttribute, ...gitlab.RequestOptionFunc) (*gitlab.CustomAttribute, *gitlab.Response, error)
+	delete func(int, string, ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+}
+
+func projectCustomAttributesAPI(client *gitlab.Client) customAttributesAPI {
+	return customAttributesAPI{
+		get:    client.CustomAttribute.GetCustomProjectAttribute,
+		set:    client.CustomAttribute.SetCustomProjectAttribute,
+		delete: client.CustomAttribute.DeleteCustomProjectAttribute,
+	}
+}
+
+func resourceGitlabProjectCustomAttributeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project_id").(int)
+	key := d.Get("key").(string)
+
+	log.Printf("[DEBUG] create gitlab project %d custom attribute %q", project, key)
+
+	if err := setCustomAttribute(projectCustomAttributesAPI(client), project, d); err != nil {
+		return err
+	}
+
+	d.SetId(buildCustomAttributeID(project, key))
+
+	return resourceGitlabProjectCustomAttributeRead(d, meta)
+}
+
+func resourceGitlabProjectCustomAttributeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	return readCustomAttribute(projectCustomAttributesAPI(client), "project_id", d)
+}
+
+func resourceGitlabProjectCustomAttributeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project_id").(int)
+
+	log.Printf("[DEBUG] update gitlab project %d custom attribute %q", project, d.Get("key").(string))
+
+	if err := setCustomAttribute(projectCustomAttributesAPI(client), project, d); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectCustomAttributeRead(d, meta)
+}
+
+func resourceGitlabProjectCustomAttributeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	return deleteCustomAttribute(projectCustomAttributesAPI(client), d)
+}
+
+func setCustomAttribute(api customAttributesAPI, id int, d *schema.ResourceData) error {
+	_, _, err := api.set(id, gitlab.CustomAttribute{
+		Key:   d.Get("key").(string),
+		Value: d.Get("value").(string),
+	})
+	return err
+}
+
+func readCustomAttribute(api customAttributesAPI, parent string, d *schema.ResourceData) error {
+	id, key, err := parseCustomAttributeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab custom attribute %s", d.Id())
+
+	attribute, resp, err := api.get(id, key)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab custom attribute %s not found so removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set(parent, id)
+	d.Set("key", attribute.Key)
+	d.Set("value", attribute.Value)
+
+	return nil
+}
+
+func deleteCustomAttribute(api customAttributesAPI, d *schema.ResourceData) error {
+	id, key, err := parseCustomAttributeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab custom attribute %s", d.Id())
+
+	resp, err := api.delete(id, key)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func buildCustomAttributeID(id int, key string) string {
+	parent := strconv.Itoa(id)
+	return buildTwoPartID(&parent, &key)
+}
+
+// parseCustomAttributeID parses the "id:key" ID of a custom attribute, where id is
+// the ID of the project, group or user.
+func parseCustomAttributeID(id string) (int, string, error) {
+	parent, key, err := parseTwoPartID(id)
+	if err != nil {
+		return 0, "", err
+	}
+
+	parentID, err := parseIntID(id, parent, "parent")
+	if err != nil {
+		return 0, "", err
+	}
+
+	return parentID, key, nil
+}
@@ -0,0 +1,93 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectCustomAttribute_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabCustomAttributeDestroy("gitlab_project_custom_attribute", projectCustomAttributesAPI),
+		Steps: []resource.TestStep{
+			// Create a custom attribute and read it with the project data source
+			{
+				Config: testAccGitlabProjectCustomAttributeConfig(rInt, "finance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_custom_attribute.foo", "key", "cost_center"),
+					resource.TestCheckResourceAttr("gitlab_project_custom_attribute.foo", "value", "finance"),
+					resource.TestCheckResourceAttr("data.gitlab_project.foo", "custom_attributes.cost_center", "finance"),
+				),
+			},
+			// Update the value
+			{
+				Config: testAccGitlabProjectCustomAttributeConfig(rInt, "engineering"),
+				Check:  resource.TestCheckResourceAttr("gitlab_project_custom_attribute.foo", "value", "engineering"),
+			},
+			{
+				ResourceName:      "gitlab_project_custom_attribute.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckGitlabCustomAttributeDestroy checks that the custom attributes of
+// the given resource type don't exist anymore.
+func testAccCheckGitlabCustomAttributeDestroy(resourceType string, api func(*gitlab.Client) customAttributesAPI) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			id, key, err := parseCustomAttributeID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, resp, err := api(conn).get(id, key)
+			if err == nil {
+				return fmt.Errorf("custom attribute %s still exists", rs.Primary.ID)
+			}
+			if resp == nil || resp.StatusCode != 404 {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabProjectCustomAttributeConfig(rInt int, value string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_project_custom_attribute" "foo" {
+  project_id = gitlab_project.foo.id
+  key        = "cost_center"
+  value      = "%[2]s"
+}
+
+data "gitlab_project" "foo" {
+  id = gitlab_project_custom_attribute.foo.project_id
+}
+`, rInt, value)
+}
@@ -0,0 +1,227 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var environmentTiers = []string{"production", "staging", "testing", "development", "other"}
+
+func resourceGitlabProjectEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectEnvironmentCreate,
+		Read:   resourceGitlabProjectEnvironmentRead,
+		Update: resourceGitlabProjectEnvironmentUpdate,
+		Delete: resourceGitlabProjectEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"external_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURLFunc,
+			},
+			"tier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateValueFunc(environmentTiers),
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// go-gitlab doesn't know about the environment tier yet, so environments are
+// read and written with raw requests.
+
+type environment struct {
+	gitlab.Environment
+	Tier string `json:"tier"`
+}
+
+type environmentOptions struct {
+	Name        *string `json:"name,omitempty"`
+	ExternalURL *string `json:"external_url,omitempty"`
+	Tier        *string `json:"tier,omitempty"`
+}
+
+func resourceGitlabProjectEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &environmentOptions{
+		Name: gitlab.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("external_url"); ok {
+		options.ExternalURL = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tier"); ok {
+		options.Tier = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab project %s environment %q", project, *options.Name)
+
+	env, err := doEnvironmentRequest(client, http.MethodPost, projectEnvironmentsPath(project), options)
+	if err != nil {
+		return err
+	}
+
+	environmentID := strconv.Itoa(env.ID)
+	d.SetId(buildTwoPartID(&project, &environmentID))
+
+	return resourceGitlabProjectEnvironmentRead(d, meta)
+}
+
+func resourceGitlabProjectEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, environmentID, err := parseTwoPartIntID(d.Id(), "environment")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab project %s environment %d", project, environmentID)
+
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%d", projectEnvironmentsPath(project), environmentID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	env := new(environment)
+	resp, err := client.Do(req, env)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab project environment %s not found so removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	d.Set("name", env.Name)
+	d.Set("external_url", env.ExternalURL)
+	d.Set("tier", env.Tier)
+	d.Set("slug", env.Slug)
+	d.Set("state", env.State)
+
+	return nil
+}
+
+func resourceGitlabProjectEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, environmentID, err := parseTwoPartIntID(d.Id(), "environment")
+	if err != nil {
+		return err
+	}
+
+	options := &environmentOptions{}
+
+	if d.HasChange("external_url") {
+		options.ExternalURL = gitlab.String(d.Get("external_url").(string))
+	}
+
+	if d.HasChange("tier") {
+		options.Tier = gitlab.String(d.Get("tier").(string))
+	}
+
+	log.Printf("[DEBUG] update gitlab project %s environment %d", project, environmentID)
+
+	path := fmt.Sprintf("%s/%d", projectEnvironmentsPath(project), environmentID)
+	if _, err := doEnvironmentRequest(client, http.MethodPut, path, options); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectEnvironmentRead(d, meta)
+}
+
+func resourceGitlabProjectEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, environmentID, err := parseTwoPartIntID(d.Id(), "environment")
+	if err != nil {
+		return err
+	}
+
+	// Only stopped environments can be deleted.
+	if d.Get("state").(string) != "stopped" {
+		log.Printf("[DEBUG] stop gitlab project %s environment %d", project, environmentID)
+
+		if _, err := client.Environments.StopEnvironment(project, environmentID); err != nil {
+			return fmt.Errorf("failed to stop environment %q: %w", d.Id(), err)
+		}
+
+		// Stopping runs the on_stop action of the environment, if there is one.
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"available", "stopping"},
+			Target:  []string{"stopped"},
+			Timeout: d.Timeout(schema.TimeoutDelete),
+			Refresh: func() (interface{}, string, error) {
+				env, _, err := client.Environments.GetEnvironment(project, environmentID)
+				if err != nil {
+					return nil, "", err
+				}
+
+				return env, env.State, nil
+			},
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("error while waiting for environment %q to stop: %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] delete gitlab project %s environment %d", project, environmentID)
+
+	_, err = client.Environments.DeleteEnvironment(project, environmentID)
+	return err
+}
+
+func projectEnvironmentsPath(project string) string {
+	return fmt.Sprintf("projects/%s/environments", pathEscape(project))
+}
+
+func doEnvironmentRequest(client *gitlab.Client, method string, path string, options *environmentOptions) (*environment, error) {
+	req, err := client.NewRequest(method, path, options, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	env := new(environment)
+	if _, err := client.Do(req, env); err != nil {
+		return nil, err
+	}
+
+	return env, nil
+}
@@ -0,0 +1,91 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectEnvironment_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			// Create an environment
+			{
+				Config: testAccGitlabProjectEnvironmentConfig(rInt, "https://review.example.com", "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_environment.foo", "name", fmt.Sprintf("review-%d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_project_environment.foo", "external_url", "https://review.example.com"),
+					resource.TestCheckResourceAttr("gitlab_project_environment.foo", "tier", "staging"),
+					resource.TestCheckResourceAttr("gitlab_project_environment.foo", "state", "available"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_environment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the environment
+			{
+				Config: testAccGitlabProjectEnvironmentConfig(rInt, "https://review2.example.com", "testing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_environment.foo", "external_url", "https://review2.example.com"),
+					resource.TestCheckResourceAttr("gitlab_project_environment.foo", "tier", "testing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectEnvironmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_environment" {
+			continue
+		}
+
+		project, environmentID, err := parseTwoPartIntID(rs.Primary.ID, "environment")
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := client.Environments.GetEnvironment(project, environmentID)
+		if err == nil {
+			return fmt.Errorf("project environment %s still exists", rs.Primary.ID)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccGitlabProjectEnvironmentConfig(rInt int, externalURL string, tier string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_project_environment" "foo" {
+  project      = gitlab_project.foo.id
+  name         = "review-%[1]d"
+  external_url = "%[2]s"
+  tier         = "%[3]s"
+}
+	`, rInt, externalURL, tier)
+}
@@ -3,6 +3,7 @@ package gitlab
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -40,6 +41,10 @@ func resourceGitlabProjectLevelMRApprovals() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"require_password_to_approve": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -49,16 +54,19 @@ func resourceGitlabProjectLevelMRApprovalsCreate(d *schema.ResourceData, meta in
 
 	projectId := d.Get("project_id").(int)
 
-	options := &gitlab.ChangeApprovalConfigurationOptions{
-		ResetApprovalsOnPush:                      gitlab.Bool(d.Get("reset_approvals_on_push").(bool)),
-		DisableOverridingApproversPerMergeRequest: gitlab.Bool(d.Get("disable_overriding_approvers_per_merge_request").(bool)),
-		MergeRequestsAuthorApproval:               gitlab.Bool(d.Get("merge_requests_author_approval").(bool)),
-		MergeRequestsDisableCommittersApproval:    gitlab.Bool(d.Get("merge_requests_disable_committers_approval").(bool)),
+	options := &changeApprovalConfigurationOptions{
+		ChangeApprovalConfigurationOptions: gitlab.ChangeApprovalConfigurationOptions{
+			ResetApprovalsOnPush:                      gitlab.Bool(d.Get("reset_approvals_on_push").(bool)),
+			DisableOverridingApproversPerMergeRequest: gitlab.Bool(d.Get("disable_overriding_approvers_per_merge_request").(bool)),
+			MergeRequestsAuthorApproval:               gitlab.Bool(d.Get("merge_requests_author_approval").(bool)),
+			MergeRequestsDisableCommittersApproval:    gitlab.Bool(d.Get("merge_requests_disable_committers_approval").(bool)),
+		},
+		RequirePasswordToApprove: gitlab.Bool(d.Get("require_password_to_approve").(bool)),
 	}
 
 	log.Printf("[DEBUG] Creating new MR approval configuration for project %d:", projectId)
 
-	if _, _, err := client.Projects.ChangeApprovalConfiguration(projectId, options); err != nil {
+	if err := changeApprovalConfiguration(client, strconv.Itoa(projectId), options); err != nil {
 		return fmt.Errorf("couldn't create approval configuration: %w", err)
 	}
 
@@ -76,7 +84,7 @@ func resourceGitlabProjectLevelMRApprovalsRead(d *schema.ResourceData, meta inte
 
 	log.Printf("[DEBUG] Reading gitlab approval configuration for project %q", projectId)
 
-	approvalConfig, _, err := client.Projects.GetApprovalConfiguration(projectId)
+	approvalConfig, err := getApprovalConfiguration(client, d.Id())
 	if err != nil {
 		return fmt.Errorf("couldn't read approval configuration: %w", err)
 	}
@@ -86,13 +94,14 @@ func resourceGitlabProjectLevelMRApprovalsRead(d *schema.ResourceData, meta inte
 	d.Set("disable_overriding_approvers_per_merge_request", approvalConfig.DisableOverridingApproversPerMergeRequest)
 	d.Set("merge_requests_author_approval", approvalConfig.MergeRequestsAuthorApproval)
 	d.Set("merge_requests_disable_committers_approval", approvalConfig.MergeRequestsDisableCommittersApproval)
+	d.Set("require_password_to_approve", approvalConfig.RequirePasswordToApprove)
 
 	return nil
 }
 
 func resourceGitlabProjectLevelMRApprovalsUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
-	options := &gitlab.ChangeApprovalConfigurationOptions{}
+	options := &changeApprovalConfigurationOptions{}
 
 	projectId := d.Id()
 	log.Printf("[DEBUG] Updating approval configuration for project %s:", projectId)
@@ -109,8 +118,11 @@ func resourceGitlabProjectLevelMRApprovalsUpdate(d *schema.ResourceData, meta in
 	if d.HasChange("merge_requests_disable_committers_approval") {
 		options.MergeRequestsDisableCommittersApproval = gitlab.Bool(d.Get("merge_requests_disable_committers_approval").(bool))
 	}
+	if d.HasChange("require_password_to_approve") {
+		options.RequirePasswordToApprove = gitlab.Bool(d.Get("require_password_to_approve").(bool))
+	}
 
-	if _, _, err := client.Projects.ChangeApprovalConfiguration(d.Id(), options); err != nil {
+	if err := changeApprovalConfiguration(client, d.Id(), options); err != nil {
 		return fmt.Errorf("couldn't update approval configuration: %w", err)
 	}
 
@@ -121,18 +133,59 @@ func resourceGitlabProjectLevelMRApprovalsDelete(d *schema.ResourceData, meta in
 	client := meta.(*gitlab.Client)
 	projectId := d.Id()
 
-	options := &gitlab.ChangeApprovalConfigurationOptions{
-		ResetApprovalsOnPush:                      gitlab.Bool(true),
-		DisableOverridingApproversPerMergeRequest: gitlab.Bool(false),
-		MergeRequestsAuthorApproval:               gitlab.Bool(false),
-		MergeRequestsDisableCommittersApproval:    gitlab.Bool(false),
+	options := &changeApprovalConfigurationOptions{
+		ChangeApprovalConfigurationOptions: gitlab.ChangeApprovalConfigurationOptions{
+			ResetApprovalsOnPush:                      gitlab.Bool(true),
+			DisableOverridingApproversPerMergeRequest: gitlab.Bool(false),
+			MergeRequestsAuthorApproval:               gitlab.Bool(false),
+			MergeRequestsDisableCommittersApproval:    gitlab.Bool(false),
+		},
+		RequirePasswordToApprove: gitlab.Bool(false),
 	}
 
 	log.Printf("[DEBUG] Resetting approval configuration for project %s:", projectId)
 
-	if _, _, err := client.Projects.ChangeApprovalConfiguration(projectId, options); err != nil {
+	if err := changeApprovalConfiguration(client, projectId, options); err != nil {
 		return fmt.Errorf("couldn't reset approval configuration: %w", err)
 	}
 
 	return nil
 }
+
+// projectApprovals represents the approval configuration of a project, including
+// the attributes that go-gitlab does not decode.
+type projectApprovals struct {
+	gitlab.ProjectApprovals
+	RequirePasswordToApprove bool `json:"require_password_to_approve"`
+}
+
+// changeApprovalConfigurationOptions represents the options to change the approval
+// configuration of a project, including the attributes that go-gitlab does not encode.
+type changeApprovalConfigurationOptions struct {
+	gitlab.ChangeApprovalConfigurationOptions
+	RequirePasswordToApprove *bool `json:"require_password_to_approve,omitempty"`
+}
+
+func getApprovalConfiguration(client *gitlab.Client, project string) (*projectApprovals, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/approvals", pathEscape(project)), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := new(projectApprovals)
+	if _, err := client.Do(req, approvals); err != nil {
+		return nil, err
+	}
+
+	return approvals, nil
+}
+
+func changeApprovalConfiguration(client *gitlab.Client, project string, options *changeApprovalConfigurationOptions) error {
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("projects/%s/approvals", pathEscape(project)), options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
@@ -0,0 +1,291 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectMembers() *schema.Resource {
+	acceptedAccessLevels := make([]string, 0, len(accessLevelID))
+	for k := range accessLevelID {
+		if k != "owner" {
+			acceptedAccessLevels = append(acceptedAccessLevels, k)
+		}
+	}
+	return &schema.Resource{
+		Create: resourceGitlabProjectMembersCreate,
+		Read:   resourceGitlabProjectMembersRead,
+		Update: resourceGitlabProjectMembersUpdate,
+		Delete: resourceGitlabProjectMembersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: membersSchema("project_id", acceptedAccessLevels),
+	}
+}
+
+// membersSchema returns the schema of the members of a project or group, with
+// parent being the attribute that holds the ID of the project or group.
+func membersSchema(parent string, acceptedAccessLevels []string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		parent: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"member": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_id": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+					"access_level": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateValueFunc(acceptedAccessLevels),
+					},
+					"expires_at": {
+						Type:         schema.TypeString, // Format YYYY-MM-DD
+						Optional:     true,
+						ValidateFunc: validateDateFunc,
+					},
+				},
+			},
+		},
+		"exclude_user_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+			Set:      schema.HashInt,
+		},
+	}
+}
+
+// member is a direct member of a project or group.
+type member struct {
+	userID      int
+	accessLevel gitlab.AccessLevelValue
+	expiresAt   string
+}
+
+// membersAPI manages the direct members of a project or group.
+type membersAPI interface {
+	list() ([]*member, *gitlab.Response, error)
+	add(m *member) error
+	edit(m *member) error
+	remove(userID int) (*gitlab.Response, error)
+}
+
+type projectMembersAPI struct {
+	client  *gitlab.Client
+	project string
+}
+
+func (a projectMembersAPI) list() ([]*member, *gitlab.Response, error) {
+	options := &gitlab.ListProjectMembersOptions{
+		ListOptions: gitlab.ListOptions{Page: 1, PerPage: 100},
+	}
+
+	var members []*member
+	for {
+		page, resp, err := a.client.ProjectMembers.ListProjectMembers(a.project, options)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, m := range page {
+			members = append(members, &member{
+				userID:      m.ID,
+				accessLevel: m.AccessLevel,
+				expiresAt:   flattenISOTime(m.ExpiresAt),
+			})
+		}
+
+		if resp.NextPage == 0 {
+			return members, resp, nil
+		}
+
+		options.Page = resp.NextPage
+	}
+}
+
+func (a projectMembersAPI) add(m *member) error {
+	_, _, err := a.client.ProjectMembers.AddProjectMember(a.project, &gitlab.AddProjectMemberOptions{
+		UserID:      &m.userID,
+		AccessLevel: &m.accessLevel,
+		ExpiresAt:   &m.expiresAt,
+	})
+	return err
+}
+
+func (a projectMembersAPI) edit(m *member) error {
+	_, _, err := a.client.ProjectMembers.EditProjectMember(a.project, m.userID, &gitlab.EditProjectMemberOptions{
+		AccessLevel: &m.accessLevel,
+		ExpiresAt:   &m.expiresAt,
+	})
+	return err
+}
+
+func (a projectMembersAPI) remove(userID int) (*gitlab.Response, error) {
+	return a.client.ProjectMembers.DeleteProjectMember(a.project, userID)
+}
+
+func resourceGitlabProjectMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("project_id").(string))
+	return resourceGitlabProjectMembersUpdate(d, meta)
+}
+
+func resourceGitlabProjectMembersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab project %s members", project)
+
+	members, resp, err := projectMembersAPI{client, project}.list()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab project %s not found so removing its members from state", project)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project_id", project)
+	if err := d.Set("member", flattenMembers(members, d)); err != nil {
+		return fmt.Errorf("error setting members: %w", err)
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] update gitlab project %s members", project)
+
+	if err := syncMembers(projectMembersAPI{client, project}, d); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectMembersRead(d, meta)
+}
+
+func resourceGitlabProjectMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab project %s members", project)
+
+	return deleteDeclaredMembers(projectMembersAPI{client, project}, d)
+}
+
+// flattenMembers flattens the members which aren't excluded by the resource.
+func flattenMembers(members []*member, d *schema.ResourceData) []interface{} {
+	excluded := d.Get("exclude_user_ids").(*schema.Set)
+
+	values := make([]interface{}, 0, len(members))
+	for _, m := range members {
+		if excluded.Contains(m.userID) {
+			continue
+		}
+		values = append(values, map[string]interface{}{
+			"user_id":      m.userID,
+			"access_level": accessLevel[m.accessLevel],
+			"expires_at":   m.expiresAt,
+		})
+	}
+	return values
+}
+
+// syncMembers makes the direct members of a project or group match the members
+// of the resource. Members which are neither declared nor excluded are removed.
+func syncMembers(api membersAPI, d *schema.ResourceData) error {
+	existing, _, err := api.list()
+	if err != nil {
+		return err
+	}
+
+	current := make(map[int]*member, len(existing))
+	for _, m := range existing {
+		current[m.userID] = m
+	}
+
+	excluded := d.Get("exclude_user_ids").(*schema.Set)
+
+	declared := make(map[int]bool)
+	for _, raw := range d.Get("member").(*schema.Set).List() {
+		v := raw.(map[string]interface{})
+		want := &member{
+			userID:      v["user_id"].(int),
+			accessLevel: accessLevelID[v["access_level"].(string)],
+			expiresAt:   v["expires_at"].(string),
+		}
+
+		if declared[want.userID] {
+			return fmt.Errorf("member %d is declared more than once", want.userID)
+		}
+		if excluded.Contains(want.userID) {
+			return fmt.Errorf("member %d is both declared and excluded", want.userID)
+		}
+		declared[want.userID] = true
+
+		got, ok := current[want.userID]
+		if !ok {
+			log.Printf("[DEBUG] add gitlab member %d", want.userID)
+
+			if err := api.add(want); err != nil {
+				return fmt.Errorf("failed to add member %d: %w", want.userID, err)
+			}
+			continue
+		}
+
+		if *got == *want {
+			continue
+		}
+
+		log.Printf("[DEBUG] edit gitlab member %d", want.userID)
+
+		if err := api.edit(want); err != nil {
+			return fmt.Errorf("failed to edit member %d: %w", want.userID, err)
+		}
+	}
+
+	for _, m := range existing {
+		if declared[m.userID] || excluded.Contains(m.userID) {
+			continue
+		}
+
+		log.Printf("[DEBUG] remove gitlab member %d", m.userID)
+
+		if _, err := api.remove(m.userID); err != nil {
+			return fmt.Errorf("failed to remove member %d: %w", m.userID, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteDeclaredMembers removes the members of the resource.
+func deleteDeclaredMembers(api membersAPI, d *schema.ResourceData) error {
+	for _, raw := range d.Get("member").(*schema.Set).List() {
+		userID := raw.(map[string]interface{})["user_id"].(int)
+
+		log.Printf("[DEBUG] remove gitlab member %d", userID)
+
+		resp, err := api.remove(userID)
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package gitlab
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectMembers_basic(t *testing.T) {
+	ctx := testAccGitlabProjectStart(t)
+	defer ctx.finish()
+
+	currentUser := testAccCurrentUser(t)
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectMembersDestroy(ctx, currentUser.ID),
+		Steps: []resource.TestStep{
+			// Add two members.
+			{
+				Config: testAccGitlabProjectMembersConfig(rInt, ctx.project.ID, currentUser.ID, `
+  member {
+    user_id      = gitlab_user.foo.id
+    access_level = "developer"
+  }
+
+  member {
+    user_id      = gitlab_user.bar.id
+    access_level = "reporter"
+    expires_at   = "2099-12-31"
+  }
+`),
+				Check: testAccCheckGitlabProjectMembers(ctx, currentUser.ID, map[string]string{
+					fmt.Sprintf("foo%d", rInt): "developer",
+					fmt.Sprintf("bar%d", rInt): "reporter until 2099-12-31",
+				}),
+			},
+			// Remove an undeclared member which was added out-of-band.
+			{
+				PreConfig: func() {
+					users, _, err := ctx.client.Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.String(fmt.Sprintf("baz%d", rInt))})
+					if err != nil || len(users) != 1 {
+						t.Fatalf("failed to find user: %v", err)
+					}
+					_, _, err = ctx.client.ProjectMembers.AddProjectMember(ctx.project.ID, &gitlab.AddProjectMemberOptions{
+						UserID:      gitlab.Int(users[0].ID),
+						AccessLevel: gitlab.AccessLevel(gitlab.GuestPermissions),
+					})
+					if err != nil {
+						t.Fatalf("failed to add member: %v", err)
+					}
+				},
+				Config: testAccGitlabProjectMembersConfig(rInt, ctx.project.ID, currentUser.ID, `
+  member {
+    user_id      = gitlab_user.foo.id
+    access_level = "developer"
+  }
+
+  member {
+    user_id      = gitlab_user.bar.id
+    access_level = "reporter"
+    expires_at   = "2099-12-31"
+  }
+`),
+				Check: testAccCheckGitlabProjectMembers(ctx, currentUser.ID, map[string]string{
+					fmt.Sprintf("foo%d", rInt): "developer",
+					fmt.Sprintf("bar%d", rInt): "reporter until 2099-12-31",
+				}),
+			},
+			// Update a member and remove one.
+			{
+				Config: testAccGitlabProjectMembersConfig(rInt, ctx.project.ID, currentUser.ID, `
+  member {
+    user_id      = gitlab_user.bar.id
+    access_level = "maintainer"
+  }
+`),
+				Check: testAccCheckGitlabProjectMembers(ctx, currentUser.ID, map[string]string{
+					fmt.Sprintf("bar%d", rInt): "maintainer",
+				}),
+			},
+			// All direct members are imported, including the excluded current user.
+			{
+				ResourceName:            "gitlab_project_members.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"exclude_user_ids", "member"},
+				ImportStateCheck: testAccCheckGitlabImportedMembers(currentUser.ID, map[string]string{
+					fmt.Sprintf("bar%d", rInt): "maintainer",
+				}),
+			},
+		},
+	})
+}
+
+// testAccCurrentUser returns the user of the token the acceptance tests run with,
+// who becomes a member of the projects and groups it creates.
+func testAccCurrentUser(t *testing.T) *gitlab.User {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar))
+		return nil
+	}
+
+	user, _, err := testAccProvider.Meta().(*gitlab.Client).Users.CurrentUser()
+	if err != nil {
+		t.Fatalf("could not get current user: %v", err)
+	}
+	return user
+}
+
+// testAccCheckGitlabProjectMembers checks the members of the test project, other
+// than the current user, by username.
+func testAccCheckGitlabProjectMembers(ctx testAccGitlabProjectContext, currentUserID int, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		members, _, err := ctx.client.ProjectMembers.ListProjectMembers(ctx.project.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		got := make(map[string]string)
+		for _, m := range members {
+			if m.ID == currentUserID {
+				continue
+			}
+			got[m.Username] = describeTestMember(m.AccessLevel, m.ExpiresAt)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("got members %v; want %v", got, want)
+		}
+		return nil
+	}
+}
+
+// testAccCheckGitlabImportedMembers checks the members of an imported project or
+// group members resource, other than the current user, by username.
+func testAccCheckGitlabImportedMembers(currentUserID int, want map[string]string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("got %d imported resources; want 1", len(states))
+		}
+		attributes := states[0].Attributes
+
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		got := make(map[string]string)
+		for key, value := range attributes {
+			if !strings.HasPrefix(key, "member.") || !strings.HasSuffix(key, ".user_id") {
+				continue
+			}
+
+			userID, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+			if userID == currentUserID {
+				continue
+			}
+
+			user, _, err := conn.Users.GetUser(userID)
+			if err != nil {
+				return err
+			}
+
+			prefix := strings.TrimSuffix(key, "user_id")
+			description := attributes[prefix+"access_level"]
+			if expiresAt := attributes[prefix+"expires_at"]; expiresAt != "" {
+				description = fmt.Sprintf("%s until %s", description, expiresAt)
+			}
+			got[user.Username] = description
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("got imported members %v; want %v", got, want)
+		}
+		return nil
+	}
+}
+
+func describeTestMember(level gitlab.AccessLevelValue, expiresAt *gitlab.ISOTime) string {
+	if expiresAt == nil {
+		return accessLevel[level]
+	}
+	return fmt.Sprintf("%s until %s", accessLevel[level], expiresAt)
+}
+
+func testAccCheckGitlabProjectMembersDestroy(ctx testAccGitlabProjectContext, currentUserID int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return testAccCheckGitlabProjectMembers(ctx, currentUserID, map[string]string{})(s)
+	}
+}
+
+func testAccGitlabProjectMembersConfig(rInt, projectID, currentUserID int, members string) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name     = "foo %[1]d"
+  username = "foo%[1]d"
+  password = "test%[1]dtt"
+  email    = "foo%[1]d@ssss.com"
+}
+
+resource "gitlab_user" "bar" {
+  name     = "bar %[1]d"
+  username = "bar%[1]d"
+  password = "test%[1]dtt"
+  email    = "bar%[1]d@ssss.com"
+}
+
+resource "gitlab_user" "baz" {
+  name     = "baz %[1]d"
+  username = "baz%[1]d"
+  password = "test%[1]dtt"
+  email    = "baz%[1]d@ssss.com"
+}
+
+resource "gitlab_project_members" "foo" {
+  project_id       = "%[2]d"
+  exclude_user_ids = [%[3]d]
+%[4]s
+}
+`, rInt, projectID, currentUserID, members)
+}
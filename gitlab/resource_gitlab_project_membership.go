@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
@@ -33,15 +34,30 @@ func resourceGitlabProjectMembership() *schema.Resource {
 				Required: true,
 			},
 			"user_id": {
-				Type:     schema.TypeInt,
-				ForceNew: true,
-				Required: true,
+				Type:         schema.TypeInt,
+				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"user_id", "username"},
+			},
+			"username": {
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"user_id", "username"},
+				DiffSuppressFunc: suppressUsernameCaseDiff,
 			},
 			"access_level": {
 				Type:         schema.TypeString,
 				ValidateFunc: validateValueFunc(acceptedAccessLevels),
 				Required:     true,
 			},
+			"expires_at": {
+				Type:         schema.TypeString, // Format YYYY-MM-DD
+				ValidateFunc: validateDateFunc,
+				Optional:     true,
+			},
 		},
 	}
 }
@@ -49,15 +65,25 @@ func resourceGitlabProjectMembership() *schema.Resource {
 func resourceGitlabProjectMembershipCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 
-	userId := d.Get("user_id").(int)
 	projectId := d.Get("project_id").(string)
+	expiresAt := d.Get("expires_at").(string)
 	accessLevelId := accessLevelID[d.Get("access_level").(string)]
 
+	userId := d.Get("user_id").(int)
+	if username, ok := d.GetOk("username"); ok {
+		var err error
+		userId, err = userIDByUsername(client, username.(string))
+		if err != nil {
+			return err
+		}
+	}
+
 	options := &gitlab.AddProjectMemberOptions{
 		UserID:      &userId,
 		AccessLevel: &accessLevelId,
+		ExpiresAt:   &expiresAt,
 	}
-	log.Printf("[DEBUG] create gitlab project membership for %d in %s", options.UserID, projectId)
+	log.Printf("[DEBUG] create gitlab project membership for %d in %s", userId, projectId)
 
 	_, _, err := client.ProjectMembers.AddProjectMember(projectId, options)
 	if err != nil {
@@ -109,10 +135,12 @@ func resourceGitlabProjectMembershipUpdate(d *schema.ResourceData, meta interfac
 
 	userId := d.Get("user_id").(int)
 	projectId := d.Get("project_id").(string)
+	expiresAt := d.Get("expires_at").(string)
 	accessLevelId := accessLevelID[strings.ToLower(d.Get("access_level").(string))]
 
 	options := gitlab.EditProjectMemberOptions{
 		AccessLevel: &accessLevelId,
+		ExpiresAt:   &expiresAt,
 	}
 	log.Printf("[DEBUG] update gitlab project membership %v for %s", userId, projectId)
 
@@ -142,8 +170,30 @@ func resourceGitlabProjectMembershipSetToState(d *schema.ResourceData, projectMe
 
 	d.Set("project_id", projectId)
 	d.Set("user_id", projectMember.ID)
+	d.Set("username", projectMember.Username)
 	d.Set("access_level", accessLevel[projectMember.AccessLevel])
+	d.Set("expires_at", flattenISOTime(projectMember.ExpiresAt))
 
 	userId := strconv.Itoa(projectMember.ID)
 	d.SetId(buildTwoPartID(projectId, &userId))
 }
+
+// userIDByUsername looks up the ID of the user with the given username.
+func userIDByUsername(client *gitlab.Client, username string) (int, error) {
+	users, _, err := client.Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.String(username)})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(users) != 1 {
+		return 0, fmt.Errorf("couldn't find a user with the username %q", username)
+	}
+
+	return users[0].ID, nil
+}
+
+// suppressUsernameCaseDiff ignores the case of usernames, which GitLab doesn't
+// distinguish.
+func suppressUsernameCaseDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
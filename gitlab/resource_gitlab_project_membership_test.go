@@ -28,11 +28,12 @@ func TestAccGitlabProjectMembership_basic(t *testing.T) {
 				})),
 			},
 
-			// Update the project member to change the access level (use testAccGitlabProjectMembershipUpdateConfig for Config)
+			// Update the project member to change the access level and expiration date (use testAccGitlabProjectMembershipUpdateConfig for Config)
 			{
 				Config: testAccGitlabProjectMembershipUpdateConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(testAccCheckGitlabProjectMembershipExists("gitlab_project_membership.foo", &membership), testAccCheckGitlabProjectMembershipAttributes(&membership, &testAccGitlabProjectMembershipExpectedAttributes{
 					access_level: fmt.Sprintf("guest"),
+					expires_at:   "2099-12-31",
 				})),
 			},
 
@@ -43,6 +44,16 @@ func TestAccGitlabProjectMembership_basic(t *testing.T) {
 					access_level: fmt.Sprintf("developer"),
 				})),
 			},
+
+			// Assign the member by username instead of user id
+			{
+				Config: testAccGitlabProjectMembershipUsernameConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(testAccCheckGitlabProjectMembershipExists("gitlab_project_membership.foo", &membership), testAccCheckGitlabProjectMembershipAttributes(&membership, &testAccGitlabProjectMembershipExpectedAttributes{
+					access_level: fmt.Sprintf("reporter"),
+				}),
+					resource.TestCheckResourceAttrPair("gitlab_project_membership.foo", "user_id", "gitlab_user.test", "id"),
+				),
+			},
 		},
 	})
 }
@@ -78,6 +89,7 @@ func testAccCheckGitlabProjectMembershipExists(n string, membership *gitlab.Proj
 
 type testAccGitlabProjectMembershipExpectedAttributes struct {
 	access_level string
+	expires_at   string
 }
 
 func testAccCheckGitlabProjectMembershipAttributes(membership *gitlab.ProjectMember, want *testAccGitlabProjectMembershipExpectedAttributes) resource.TestCheckFunc {
@@ -90,6 +102,9 @@ func testAccCheckGitlabProjectMembershipAttributes(membership *gitlab.ProjectMem
 		if access_level_id != want.access_level {
 			return fmt.Errorf("got access level %s; want %s", access_level_id, want.access_level)
 		}
+		if expiresAt := flattenISOTime(membership.ExpiresAt); expiresAt != want.expires_at {
+			return fmt.Errorf("got expires at %q; want %q", expiresAt, want.expires_at)
+		}
 		return nil
 	}
 }
@@ -152,6 +167,7 @@ resource "gitlab_project_membership" "foo" {
   project_id = "${gitlab_project.foo.id}"
   user_id = "${gitlab_user.test.id}"
   access_level = "guest"
+  expires_at = "2099-12-31"
 }
 
 resource "gitlab_project" "foo" {
@@ -168,3 +184,26 @@ resource "gitlab_user" "test" {
 }
 `, rInt, rInt, rInt, rInt, rInt)
 }
+
+func testAccGitlabProjectMembershipUsernameConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_project_membership" "foo" {
+  project_id = "${gitlab_project.foo.id}"
+  username = "${gitlab_user.test.username}"
+  access_level = "reporter"
+}
+
+resource "gitlab_project" "foo" {
+  name = "foo%d"
+  description = "Terraform acceptance tests"
+  visibility_level ="public"
+}
+
+resource "gitlab_user" "test" {
+  name = "foo%d"
+  username = "listest%d"
+  password = "test%dtt"
+  email = "listest%d@ssss.com"
+}
+`, rInt, rInt, rInt, rInt, rInt)
+}
@@ -0,0 +1,260 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectMilestone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectMilestoneCreate,
+		Read:   resourceGitlabProjectMilestoneRead,
+		Update: resourceGitlabProjectMilestoneUpdate,
+		Delete: resourceGitlabProjectMilestoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: milestoneSchema("project", map[string]*schema.Schema{
+			"web_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+// milestoneSchema returns the schema of a project or group milestone, with parent
+// being the attribute that holds the ID of the project or group.
+func milestoneSchema(parent string, extra map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		parent: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"title": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"start_date": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDateFunc,
+		},
+		"due_date": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDateFunc,
+		},
+		"state": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "active",
+			ValidateFunc: validateValueFunc([]string{"active", "closed"}),
+		},
+		"milestone_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"iid": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"expired": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+
+	for k, v := range extra {
+		s[k] = v
+	}
+
+	return s
+}
+
+func resourceGitlabProjectMilestoneCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.CreateMilestoneOptions{
+		Title:     gitlab.String(d.Get("title").(string)),
+		StartDate: expandMilestoneDate(d, "start_date"),
+		DueDate:   expandMilestoneDate(d, "due_date"),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab project %s milestone %q", project, *options.Title)
+
+	milestone, _, err := client.Milestones.CreateMilestone(project, options)
+	if err != nil {
+		return err
+	}
+
+	milestoneID := strconv.Itoa(milestone.ID)
+	d.SetId(buildTwoPartID(&project, &milestoneID))
+
+	// Milestones are always created active, so closing them takes another request.
+	if d.Get("state").(string) == "closed" {
+		_, _, err := client.Milestones.UpdateMilestone(project, milestone.ID, &gitlab.UpdateMilestoneOptions{
+			StateEvent: gitlab.String("close"),
+		})
+		if err != nil {
+			return fmt.Errorf("new milestone %q could not be closed: %w", d.Id(), err)
+		}
+	}
+
+	return resourceGitlabProjectMilestoneRead(d, meta)
+}
+
+func resourceGitlabProjectMilestoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, milestoneID, err := parseTwoPartIntID(d.Id(), "milestone")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab project %s milestone %d", project, milestoneID)
+
+	milestone, resp, err := client.Milestones.GetMilestone(project, milestoneID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab project milestone %s not found so removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	d.Set("title", milestone.Title)
+	d.Set("description", milestone.Description)
+	d.Set("start_date", flattenMilestoneDate(milestone.StartDate))
+	d.Set("due_date", flattenMilestoneDate(milestone.DueDate))
+	d.Set("state", milestone.State)
+	d.Set("milestone_id", milestone.ID)
+	d.Set("iid", milestone.IID)
+	d.Set("expired", milestone.Expired != nil && *milestone.Expired)
+	d.Set("web_url", milestone.WebURL)
+
+	return nil
+}
+
+func resourceGitlabProjectMilestoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, milestoneID, err := parseTwoPartIntID(d.Id(), "milestone")
+	if err != nil {
+		return err
+	}
+
+	options := &gitlab.UpdateMilestoneOptions{
+		StartDate:  expandMilestoneDate(d, "start_date"),
+		DueDate:    expandMilestoneDate(d, "due_date"),
+		StateEvent: expandMilestoneStateEvent(d),
+	}
+
+	if d.HasChange("title") {
+		options.Title = gitlab.String(d.Get("title").(string))
+	}
+
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+
+	log.Printf("[DEBUG] update gitlab project %s milestone %d", project, milestoneID)
+
+	if _, _, err := client.Milestones.UpdateMilestone(project, milestoneID, options); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("projects/%s/milestones/%d", pathEscape(project), milestoneID)
+	if err := clearMilestoneDates(client, path, d); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectMilestoneRead(d, meta)
+}
+
+func resourceGitlabProjectMilestoneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, milestoneID, err := parseTwoPartIntID(d.Id(), "milestone")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab project %s milestone %d", project, milestoneID)
+
+	_, err = client.Milestones.DeleteMilestone(project, milestoneID)
+	return err
+}
+
+// expandMilestoneDate returns the date at key if it is set, the dates are validated
+// by the schema so they always parse.
+func expandMilestoneDate(d *schema.ResourceData, key string) *gitlab.ISOTime {
+	v, ok := d.GetOk(key)
+	if !ok {
+		return nil
+	}
+
+	date, err := time.Parse("2006-01-02", v.(string))
+	if err != nil {
+		return nil
+	}
+
+	isoDate := gitlab.ISOTime(date)
+	return &isoDate
+}
+
+func flattenMilestoneDate(date *gitlab.ISOTime) string {
+	if date == nil {
+		return ""
+	}
+	return date.String()
+}
+
+func expandMilestoneStateEvent(d *schema.ResourceData) *string {
+	if !d.HasChange("state") {
+		return nil
+	}
+
+	if d.Get("state").(string) == "closed" {
+		return gitlab.String("close")
+	}
+	return gitlab.String("activate")
+}
+
+// clearMilestoneDates clears the dates which were removed from the configuration.
+// go-gitlab omits empty dates, so this needs a raw request.
+func clearMilestoneDates(client *gitlab.Client, path string, d *schema.ResourceData) error {
+	options := map[string]interface{}{}
+	for _, key := range []string{"start_date", "due_date"} {
+		if d.HasChange(key) && d.Get(key).(string) == "" {
+			options[key] = nil
+		}
+	}
+
+	if len(options) == 0 {
+		return nil
+	}
+
+	req, err := client.NewRequest(http.MethodPut, path, options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
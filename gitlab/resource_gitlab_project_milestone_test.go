@@ -0,0 +1,104 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectMilestone_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectMilestoneDestroy,
+		Steps: []resource.TestStep{
+			// Create a milestone with dates
+			{
+				Config: testAccGitlabProjectMilestoneConfig(rInt, `
+start_date = "2021-01-01"
+due_date   = "2021-03-31"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "title", fmt.Sprintf("Q1 %d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "start_date", "2021-01-01"),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "due_date", "2021-03-31"),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "state", "active"),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "expired", "true"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_milestone.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Close the milestone and remove its dates
+			{
+				Config: testAccGitlabProjectMilestoneConfig(rInt, `state = "closed"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "start_date", ""),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "due_date", ""),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "state", "closed"),
+				),
+			},
+			// Reopen the milestone
+			{
+				Config: testAccGitlabProjectMilestoneConfig(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectMilestoneDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_milestone" {
+			continue
+		}
+
+		project, milestoneID, err := parseTwoPartIntID(rs.Primary.ID, "milestone")
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := client.Milestones.GetMilestone(project, milestoneID)
+		if err == nil {
+			return fmt.Errorf("project milestone %s still exists", rs.Primary.ID)
+		}
+		if resp == nil || resp.StatusCode != 404 {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccGitlabProjectMilestoneConfig(rInt int, attributes string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%[1]d"
+  path = "foo-%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_project_milestone" "foo" {
+  project     = gitlab_project.foo.id
+  title       = "Q1 %[1]d"
+  description = "First quarter"
+%[2]s
+}
+	`, rInt, attributes)
+}
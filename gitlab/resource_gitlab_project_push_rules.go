@@ -0,0 +1,111 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectPushRules() *schema.Resource {
+	s := projectPushRulesSchema()
+	s["project"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceGitlabProjectPushRulesCreate,
+		Read:   resourceGitlabProjectPushRulesRead,
+		Update: resourceGitlabProjectPushRulesUpdate,
+		Delete: resourceGitlabProjectPushRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: s,
+	}
+}
+
+func resourceGitlabProjectPushRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	log.Printf("[DEBUG] create gitlab project %s push rules", project)
+
+	if err := editOrAddPushRules(client, project, d, ""); err != nil {
+		return augmentProjectPushRulesClientError(project, err)
+	}
+
+	d.SetId(project)
+
+	return resourceGitlabProjectPushRulesRead(d, meta)
+}
+
+func resourceGitlabProjectPushRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read gitlab project %s push rules", d.Id())
+
+	pushRules, resp, err := client.Projects.GetProjectPushRules(d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab project %s push rules not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// GitLab answers with an empty body when the project has no push rules.
+	if pushRules == nil || pushRules.ID == 0 {
+		log.Printf("[DEBUG] gitlab project %s has no push rules, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", d.Id())
+	for key, value := range flattenProjectPushRules(pushRules)[0] {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectPushRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] update gitlab project %s push rules", d.Id())
+
+	if err := editOrAddPushRules(client, d.Id(), d, ""); err != nil {
+		return augmentProjectPushRulesClientError(d.Id(), err)
+	}
+
+	return resourceGitlabProjectPushRulesRead(d, meta)
+}
+
+func resourceGitlabProjectPushRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] delete gitlab project %s push rules", d.Id())
+
+	resp, err := client.Projects.DeleteProjectPushRule(d.Id())
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func augmentProjectPushRulesClientError(project string, err error) error {
+	var httpError *gitlab.ErrorResponse
+	if errors.As(err, &httpError) && httpError.Response.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Failed to edit push rules for project %q: %v", project, err)
+		return errors.New("Project push rules are not supported in your version of GitLab")
+	}
+
+	return fmt.Errorf("Failed to edit push rules for project %q: %w", project, err)
+}
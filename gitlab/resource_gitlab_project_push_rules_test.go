@@ -0,0 +1,110 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectPushRules_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			// Add all push rules to a project
+			{
+				SkipFunc: isRunningInCE,
+				Config: testAccGitlabProjectPushRulesConfig(rInt, `
+author_email_regex = "foo_author"
+branch_name_regex = "foo_branch"
+commit_message_regex = "foo_commit"
+commit_message_negative_regex = "foo_not_commit"
+file_name_regex = "foo_file"
+commit_committer_check = true
+deny_delete_tag = true
+member_check = true
+prevent_secrets = true
+reject_unsigned_commits = true
+max_file_size = 123
+`),
+				Check: testAccCheckGitlabProjectPushRules("gitlab_project_push_rules.foo", &gitlab.ProjectPushRules{
+					AuthorEmailRegex:           "foo_author",
+					BranchNameRegex:            "foo_branch",
+					CommitMessageRegex:         "foo_commit",
+					CommitMessageNegativeRegex: "foo_not_commit",
+					FileNameRegex:              "foo_file",
+					CommitCommitterCheck:       true,
+					DenyDeleteTag:              true,
+					MemberCheck:                true,
+					PreventSecrets:             true,
+					RejectUnsignedCommits:      true,
+					MaxFileSize:                123,
+				}),
+			},
+			{
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_project_push_rules.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the push rules, unset attributes are cleared
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabProjectPushRulesConfig(rInt, `author_email_regex = "bar_author"`),
+				Check: testAccCheckGitlabProjectPushRules("gitlab_project_push_rules.foo", &gitlab.ProjectPushRules{
+					AuthorEmailRegex: "bar_author",
+				}),
+			},
+			// Remove the push rules resource, the push rules are deleted
+			{
+				SkipFunc: isRunningInCE,
+				Config:   testAccGitlabProjectConfig(rInt),
+				Check:    testAccCheckGitlabProjectPushRulesDeleted("gitlab_project.foo"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectPushRulesDeleted(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		client := testAccProvider.Meta().(*gitlab.Client)
+		projectResource := state.RootModule().Resources[name].Primary
+
+		pushRules, _, err := client.Projects.GetProjectPushRules(projectResource.ID)
+		if err != nil {
+			return err
+		}
+
+		if pushRules != nil && pushRules.ID != 0 {
+			return fmt.Errorf("push rules of project %s still exist", projectResource.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccGitlabProjectPushRulesConfig(rInt int, pushRules string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%[1]d"
+  path = "foo.%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_project_push_rules" "foo" {
+  project = gitlab_project.foo.id
+%[2]s
+}
+	`, rInt, pushRules)
+}
@@ -394,6 +394,76 @@ func TestAccGitlabProject_willError(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProject_settings(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabProjectConfigSettings(rInt, "enabled", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.foo", "ci_config_path", ".gitlab/ci.yml"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "build_timeout", "3600"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "auto_devops_enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "auto_cancel_pending_pipelines", "enabled"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "issues_access_level", "enabled"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "wiki_access_level", "enabled"),
+				),
+			},
+			{
+				Config: testAccGitlabProjectConfigSettings(rInt, "private", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.foo", "build_timeout", "7200"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "issues_access_level", "private"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "repository_access_level", "private"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "builds_access_level", "private"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "wiki_access_level", "private"),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "operations_access_level", "private"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_avatar(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			// Create a project with an avatar
+			{
+				Config: testAccGitlabProjectConfigAvatar(rInt, "testdata/avatar.png"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("gitlab_project.foo", "avatar_url", regexp.MustCompile(`avatar\.png$`)),
+				),
+			},
+			// Replace the avatar
+			{
+				Config: testAccGitlabProjectConfigAvatar(rInt, "testdata/avatar-update.png"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("gitlab_project.foo", "avatar_url", regexp.MustCompile(`avatar-update\.png$`)),
+				),
+			},
+			// Remove the avatar
+			{
+				Config: testAccGitlabProjectConfigAvatar(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.foo", "avatar_url", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGitlabProject_import(t *testing.T) {
 	rInt := acctest.RandInt()
 	resource.Test(t, resource.TestCase{
@@ -1000,6 +1070,52 @@ resource "gitlab_project" "foo" {
 	`, rInt, rInt)
 }
 
+func testAccGitlabProjectConfigSettings(rInt int, accessLevel string, buildTimeout int) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%[1]d"
+  path = "foo.%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+
+  ci_config_path                = ".gitlab/ci.yml"
+  build_timeout                 = %[3]d
+  auto_devops_enabled           = false
+  auto_cancel_pending_pipelines = "enabled"
+  issues_access_level           = "%[2]s"
+  repository_access_level       = "%[2]s"
+  builds_access_level           = "%[2]s"
+  wiki_access_level             = "%[2]s"
+  operations_access_level       = "%[2]s"
+}
+	`, rInt, accessLevel, buildTimeout)
+}
+
+func testAccGitlabProjectConfigAvatar(rInt int, avatar string) string {
+	avatarHash := `""`
+	if avatar != "" {
+		avatarHash = fmt.Sprintf("filesha256(%q)", avatar)
+	}
+
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%[1]d"
+  path = "foo.%[1]d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+
+  avatar      = "%[2]s"
+  avatar_hash = %[3]s
+}
+	`, rInt, avatar, avatarHash)
+}
+
 func testAccGitlabProjectConfigInitializeWithReadme(rInt int) string {
 	return fmt.Sprintf(`
 resource "gitlab_project" "foo" {
@@ -1,8 +1,11 @@
 package gitlab
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"net/url"
@@ -22,6 +25,7 @@ func resourceGitlabProjectVariable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceGitlabVariableCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"project": {
@@ -36,8 +40,20 @@ func resourceGitlabProjectVariable() *schema.Resource {
 				ValidateFunc: StringIsGitlabVariableName,
 			},
 			"value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_file"},
+			},
+			"value_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "value_file"},
+			},
+			"value_file_hash": {
 				Type:      schema.TypeString,
-				Required:  true,
+				Computed:  true,
 				Sensitive: true,
 			},
 			"variable_type": {
@@ -63,6 +79,10 @@ func resourceGitlabProjectVariable() *schema.Resource {
 				// Versions of GitLab prior to 13.4 cannot update environment_scope.
 				ForceNew: true,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -72,28 +92,33 @@ func resourceGitlabProjectVariableCreate(d *schema.ResourceData, meta interface{
 
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
-	value := d.Get("value").(string)
+	value, err := expandVariableValue(d)
+	if err != nil {
+		return err
+	}
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
 	environmentScope := d.Get("environment_scope").(string)
+	description := d.Get("description").(string)
 
-	options := gitlab.CreateProjectVariableOptions{
+	options := variableOptions{
 		Key:              &key,
 		Value:            &value,
 		VariableType:     variableType,
 		Protected:        &protected,
 		Masked:           &masked,
 		EnvironmentScope: &environmentScope,
+		Description:      &description,
 	}
 
 	id := strings.Join([]string{project, key, environmentScope}, ":")
 
 	log.Printf("[DEBUG] create gitlab project variable %q", id)
 
-	_, _, err := client.ProjectVariables.CreateVariable(project, &options)
+	err = createVariable(client, projectVariablesPath(project), &options)
 	if err != nil {
-		return augmentProjectVariableClientError(d, err)
+		return augmentVariableClientError(d, err)
 	}
 
 	d.SetId(id)
@@ -135,16 +160,14 @@ func resourceGitlabProjectVariableRead(d *schema.ResourceData, meta interface{})
 			d.SetId("")
 			return nil
 		}
-		return augmentProjectVariableClientError(d, err)
+		return augmentVariableClientError(d, err)
 	}
 
-	d.Set("key", v.Key)
-	d.Set("value", v.Value)
-	d.Set("variable_type", v.VariableType)
 	d.Set("project", project)
-	d.Set("protected", v.Protected)
-	d.Set("masked", v.Masked)
-	d.Set("environment_scope", v.EnvironmentScope)
+	for k, v := range flattenVariable(v) {
+		d.Set(k, v)
+	}
+	setVariableValueFileHash(d, v.Value)
 	return nil
 }
 
@@ -153,24 +176,29 @@ func resourceGitlabProjectVariableUpdate(d *schema.ResourceData, meta interface{
 
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
-	value := d.Get("value").(string)
+	value, err := expandVariableValue(d)
+	if err != nil {
+		return err
+	}
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
 	environmentScope := d.Get("environment_scope").(string)
+	description := d.Get("description").(string)
 
-	options := &gitlab.UpdateProjectVariableOptions{
+	options := &variableOptions{
 		Value:            &value,
 		VariableType:     variableType,
 		Protected:        &protected,
 		Masked:           &masked,
 		EnvironmentScope: &environmentScope,
+		Description:      &description,
 	}
 	log.Printf("[DEBUG] update gitlab project variable %q", d.Id())
 
-	_, _, err := client.ProjectVariables.UpdateVariable(project, key, options, withEnvironmentScopeFilter(environmentScope))
+	err = updateVariable(client, projectVariablesPath(project), key, options, withEnvironmentScopeFilter(environmentScope))
 	if err != nil {
-		return augmentProjectVariableClientError(d, err)
+		return augmentVariableClientError(d, err)
 	}
 
 	return resourceGitlabProjectVariableRead(d, meta)
@@ -188,10 +216,79 @@ func resourceGitlabProjectVariableDelete(d *schema.ResourceData, meta interface{
 	// destroying or updating scoped variables.
 	// ref: https://gitlab.com/gitlab-org/gitlab/-/merge_requests/39209
 	_, err := client.ProjectVariables.RemoveVariable(project, key, withEnvironmentScopeFilter(environmentScope))
-	return augmentProjectVariableClientError(d, err)
+	return augmentVariableClientError(d, err)
 }
 
-func augmentProjectVariableClientError(d *schema.ResourceData, err error) error {
+// resourceGitlabVariableCustomizeDiff plans an update when the contents of the
+// value_file changed and validates masked variables.
+func resourceGitlabVariableCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	value := d.Get("value").(string)
+	valueKnown := d.NewValueKnown("value")
+
+	if path, ok := d.GetOk("value_file"); ok {
+		if !d.NewValueKnown("value_file") {
+			return nil
+		}
+
+		content, err := readVariableValueFile(path.(string))
+		if err != nil {
+			return err
+		}
+
+		// Only the hash of the contents is part of the plan, not the contents themselves.
+		if hash := hashVariableValue(content); hash != d.Get("value_file_hash").(string) {
+			if err := d.SetNew("value_file_hash", hash); err != nil {
+				return err
+			}
+			if err := d.SetNewComputed("value"); err != nil {
+				return err
+			}
+		}
+
+		value = content
+		valueKnown = true
+	}
+
+	if !valueKnown {
+		return nil
+	}
+
+	return validateMaskedVariable(d.Get("key").(string), d.Get("masked").(bool), value)
+}
+
+// expandVariableValue returns the configured value of a variable, which is
+// either the value itself or the contents of the value_file.
+func expandVariableValue(d *schema.ResourceData) (string, error) {
+	if path, ok := d.GetOk("value_file"); ok {
+		return readVariableValueFile(path.(string))
+	}
+	return d.Get("value").(string), nil
+}
+
+// setVariableValueFileHash stores the hash of the value of a variable whose value
+// is read from a value_file, so changes to the file or the value are detected.
+func setVariableValueFileHash(d *schema.ResourceData, value string) {
+	if _, ok := d.GetOk("value_file"); ok {
+		d.Set("value_file_hash", hashVariableValue(value))
+		return
+	}
+	d.Set("value_file_hash", "")
+}
+
+func readVariableValueFile(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read value_file %q: %w", path, err)
+	}
+	return string(content), nil
+}
+
+func hashVariableValue(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func augmentVariableClientError(d *schema.ResourceData, err error) error {
 	// Masked values will commonly error due to their strict requirements, and the error message from the GitLab API is not very informative,
 	// so we return a custom error message in this case.
 	if d.Get("masked").(bool) && isInvalidValueError(err) {
@@ -224,28 +321,137 @@ func withEnvironmentScopeFilter(environmentScope string) gitlab.RequestOptionFun
 
 var errProjectVariableNotExist = errors.New("project variable does not exist")
 
-func getProjectVariable(client *gitlab.Client, project interface{}, key, environmentScope string) (*gitlab.ProjectVariable, error) {
+func getProjectVariable(client *gitlab.Client, project string, key, environmentScope string) (*variable, error) {
+	v, err := findVariable(client, projectVariablesPath(project), key, environmentScope)
+	if errors.Is(err, errVariableNotExist) {
+		return nil, errProjectVariableNotExist
+	}
+	return v, err
+}
+
+func projectVariablesPath(project string) string {
+	return fmt.Sprintf("projects/%s/variables", pathEscape(project))
+}
+
+// variable is a project, group or instance variable. go-gitlab doesn't know about
+// all of their attributes yet, so variables are read and written with raw requests.
+type variable struct {
+	gitlab.ProjectVariable
+	Description string `json:"description"`
+}
+
+type variableOptions struct {
+	Key              *string                   `url:"key,omitempty" json:"key,omitempty"`
+	Value            *string                   `url:"value,omitempty" json:"value,omitempty"`
+	VariableType     *gitlab.VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
+	Protected        *bool                     `url:"protected,omitempty" json:"protected,omitempty"`
+	Masked           *bool                     `url:"masked,omitempty" json:"masked,omitempty"`
+	EnvironmentScope *string                   `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
+	Description      *string                   `url:"description,omitempty" json:"description,omitempty"`
+}
+
+var errVariableNotExist = errors.New("variable does not exist")
+
+// findVariable returns the variable with the given key and environment scope from the
+// variables at path, e.g. "projects/42/variables".
+func findVariable(client *gitlab.Client, path string, key, environmentScope string) (*variable, error) {
 	// List and filter variables manually to support GitLab versions < v13.4 (2020-08-22)
 	// ref: https://gitlab.com/gitlab-org/gitlab/-/merge_requests/39209
 
-	page := 1
+	variables, err := listVariables(client, path)
+	if err != nil {
+		return nil, err
+	}
 
+	for _, v := range variables {
+		if v.Key == key && v.EnvironmentScope == environmentScope {
+			return v, nil
+		}
+	}
+
+	return nil, errVariableNotExist
+}
+
+// listVariables returns all variables at path, e.g. "projects/42/variables".
+func listVariables(client *gitlab.Client, path string) ([]*variable, error) {
+	options := &gitlab.ListOptions{Page: 1, PerPage: 100}
+
+	var variables []*variable
 	for {
-		projectVariables, resp, err := client.ProjectVariables.ListVariables(project, &gitlab.ListProjectVariablesOptions{Page: page})
+		req, err := client.NewRequest(http.MethodGet, path, options, nil)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, v := range projectVariables {
-			if v.Key == key && v.EnvironmentScope == environmentScope {
-				return v, nil
-			}
+		var page []*variable
+		resp, err := client.Do(req, &page)
+		if err != nil {
+			return nil, err
 		}
 
+		variables = append(variables, page...)
+
 		if resp.NextPage == 0 {
-			return nil, errProjectVariableNotExist
+			return variables, nil
 		}
 
-		page++
+		options.Page = resp.NextPage
+	}
+}
+
+// flattenVariable returns the attributes of a variable as they are stored in the state.
+func flattenVariable(v *variable) map[string]interface{} {
+	return map[string]interface{}{
+		"key":               v.Key,
+		"value":             v.Value,
+		"variable_type":     string(v.VariableType),
+		"protected":         v.Protected,
+		"masked":            v.Masked,
+		"environment_scope": v.EnvironmentScope,
+		"description":       v.Description,
 	}
 }
+
+func getVariable(client *gitlab.Client, path string, key string) (*variable, *gitlab.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", path, url.PathEscape(key)), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(variable)
+	resp, err := client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+func createVariable(client *gitlab.Client, path string, opt *variableOptions) error {
+	req, err := client.NewRequest(http.MethodPost, path, opt, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func updateVariable(client *gitlab.Client, path string, key string, opt *variableOptions, options ...gitlab.RequestOptionFunc) error {
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("%s/%s", path, url.PathEscape(key)), opt, options)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func deleteVariable(client *gitlab.Client, path string, key string, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", path, url.PathEscape(key)), nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(req, nil)
+}
@@ -2,7 +2,9 @@ package gitlab
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"testing"
@@ -72,6 +74,7 @@ func testAccCheckGitlabProjectVariableExists(client *gitlab.Client, name string)
 		protected        string
 		masked           string
 		environmentScope string
+		description      string
 	)
 
 	return resource.ComposeTestCheckFunc(
@@ -90,6 +93,7 @@ func testAccCheckGitlabProjectVariableExists(client *gitlab.Client, name string)
 			protected = strconv.FormatBool(got.Protected)
 			masked = strconv.FormatBool(got.Masked)
 			environmentScope = got.EnvironmentScope
+			description = got.Description
 
 			return nil
 		},
@@ -102,6 +106,7 @@ func testAccCheckGitlabProjectVariableExists(client *gitlab.Client, name string)
 			resource.TestCheckResourceAttrPtr(name, "masked", &masked),
 			resource.TestCheckResourceAttrPtr(name, "protected", &protected),
 			resource.TestCheckResourceAttrPtr(name, "environment_scope", &environmentScope),
+			resource.TestCheckResourceAttrPtr(name, "description", &description),
 		),
 	)
 }
@@ -194,6 +199,7 @@ resource "gitlab_project_variable" "foo" {
   value = "my_value_2"
   protected = true
   masked = true
+  description = "my description"
 }
 `, ctx.project.ID),
 				Check: testAccCheckGitlabProjectVariableExists(ctx.client, "gitlab_project_variable.foo"),
@@ -217,7 +223,7 @@ EOF
 }
 `, ctx.project.ID),
 				ExpectError: regexp.MustCompile(regexp.QuoteMeta(
-					"Invalid value for a masked variable. Check the masked variable requirements: https://docs.gitlab.com/ee/ci/variables/#masked-variable-requirements",
+					`invalid value for masked variable "my_key": the value of a masked variable must be a single line`,
 				)),
 			},
 		},
@@ -345,3 +351,63 @@ resource "gitlab_project_variable" "bar" {
 		},
 	})
 }
+
+func TestAccGitlabProjectVariable_valueFile(t *testing.T) {
+	ctx := testAccGitlabProjectStart(t)
+	defer ctx.finish()
+
+	valueFile := filepath.Join(t.TempDir(), "kubeconfig")
+	writeValueFile := func(content string) {
+		if err := ioutil.WriteFile(valueFile, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write value file: %v", err)
+		}
+	}
+	writeValueFile("apiVersion: v1\nkind: Config\n")
+
+	config := fmt.Sprintf(`
+resource "gitlab_project_variable" "foo" {
+  project = %d
+  key = "KUBECONFIG"
+  value_file = %q
+  variable_type = "file"
+}
+`, ctx.project.ID, valueFile)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGitlabProjectVariableCheckAllVariablesDestroyed(ctx),
+		Steps: []resource.TestStep{
+			// Create a variable from the contents of a file.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectVariableExists(ctx.client, "gitlab_project_variable.foo"),
+					resource.TestCheckResourceAttr("gitlab_project_variable.foo", "value", "apiVersion: v1\nkind: Config\n"),
+					resource.TestCheckResourceAttr("gitlab_project_variable.foo", "value_file_hash", hashVariableValue("apiVersion: v1\nkind: Config\n")),
+				),
+			},
+			// Change the contents of the file without changing the configuration.
+			{
+				PreConfig: func() { writeValueFile("apiVersion: v1\nkind: Config\nclusters: []\n") },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectVariableExists(ctx.client, "gitlab_project_variable.foo"),
+					resource.TestCheckResourceAttr("gitlab_project_variable.foo", "value", "apiVersion: v1\nkind: Config\nclusters: []\n"),
+				),
+			},
+			// Setting both value and value_file is not allowed.
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variable" "foo" {
+  project = %d
+  key = "KUBECONFIG"
+  value = "inline"
+  value_file = %q
+}
+`, ctx.project.ID, valueFile),
+				ExpectError: regexp.MustCompile(`only one of .value,value_file. can be specified`),
+			},
+		},
+	})
+}
@@ -0,0 +1,334 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectVariables() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectVariablesCreate,
+		Read:   resourceGitlabProjectVariablesRead,
+		Update: resourceGitlabProjectVariablesUpdate,
+		Delete: resourceGitlabProjectVariablesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceGitlabVariablesCustomizeDiff,
+		Schema:        variablesSchema("project"),
+	}
+}
+
+// variablesSchema returns the schema of the variables of a project or group, with
+// parent being the attribute that holds the ID of the project or group.
+func variablesSchema(parent string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		parent: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"variable": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: StringIsGitlabVariableName,
+					},
+					"value": {
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+					"value_file": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"variable_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "env_var",
+						ValidateFunc: StringIsGitlabVariableType,
+					},
+					"protected": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"masked": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"environment_scope": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "*",
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"value_file_hashes": {
+			Type:      schema.TypeMap,
+			Computed:  true,
+			Sensitive: true,
+			Elem:      &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func resourceGitlabProjectVariablesCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("project").(string))
+	return resourceGitlabProjectVariablesUpdate(d, meta)
+}
+
+func resourceGitlabProjectVariablesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab project %s variables", project)
+
+	variables, err := listVariables(client, projectVariablesPath(project))
+	if err != nil {
+		return err
+	}
+
+	values, hashes := flattenVariables(variables, d)
+	d.Set("project", project)
+	if err := d.Set("variable", values); err != nil {
+		return fmt.Errorf("error setting variables: %w", err)
+	}
+	d.Set("value_file_hashes", hashes)
+
+	return nil
+}
+
+func resourceGitlabProjectVariablesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] update gitlab project %s variables", project)
+
+	if err := syncVariables(client, projectVariablesPath(project), d); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectVariablesRead(d, meta)
+}
+
+func resourceGitlabProjectVariablesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab project %s variables", project)
+
+	return deleteDeclaredVariables(client, projectVariablesPath(project), d)
+}
+
+// resourceGitlabVariablesCustomizeDiff plans an update when the contents of a
+// value_file changed and validates the masked variables of the resource, like
+// resourceGitlabVariableCustomizeDiff does for single variables.
+func resourceGitlabVariablesCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("variable") {
+		return nil
+	}
+
+	hashes := make(map[string]interface{})
+	for _, raw := range d.Get("variable").(*schema.Set).List() {
+		v := raw.(map[string]interface{})
+		id := variableID(v["key"].(string), v["environment_scope"].(string))
+
+		value := v["value"].(string)
+		if path := v["value_file"].(string); path != "" {
+			if value != "" {
+				return fmt.Errorf("only one of value and value_file can be specified for variable %q", id)
+			}
+
+			content, err := readVariableValueFile(path)
+			if err != nil {
+				return err
+			}
+
+			hashes[id] = hashVariableValue(content)
+			value = content
+		}
+
+		if err := validateMaskedVariable(id, v["masked"].(bool), value); err != nil {
+			return err
+		}
+	}
+
+	// Only the hashes of the contents are part of the plan, not the contents themselves.
+	if !reflect.DeepEqual(hashes, d.Get("value_file_hashes").(map[string]interface{})) {
+		return d.SetNew("value_file_hashes", hashes)
+	}
+
+	return nil
+}
+
+// variableID identifies a variable among the variables of a project or group.
+func variableID(key, environmentScope string) string {
+	return key + ":" + environmentScope
+}
+
+// flattenVariables flattens variables, keeping the value_file of the variables
+// of the resource that declare one instead of their value. It also returns the
+// hashes of the values of those variables, so changes to them are detected.
+func flattenVariables(variables []*variable, d *schema.ResourceData) ([]interface{}, map[string]interface{}) {
+	valueFiles := make(map[string]string)
+	for _, raw := range d.Get("variable").(*schema.Set).List() {
+		v := raw.(map[string]interface{})
+		if path := v["value_file"].(string); path != "" {
+			valueFiles[variableID(v["key"].(string), v["environment_scope"].(string))] = path
+		}
+	}
+
+	values := make([]interface{}, 0, len(variables))
+	hashes := make(map[string]interface{})
+	for _, v := range variables {
+		id := variableID(v.Key, v.EnvironmentScope)
+		value := flattenVariable(v)
+		value["value_file"] = ""
+		if path, ok := valueFiles[id]; ok {
+			value["value"] = ""
+			value["value_file"] = path
+			hashes[id] = hashVariableValue(v.Value)
+		}
+		values = append(values, value)
+	}
+	return values, hashes
+}
+
+// expandVariablesVariable returns the attributes of a variable of the resource
+// as they are stored in GitLab, with the contents of its value_file as value.
+func expandVariablesVariable(v map[string]interface{}) (map[string]interface{}, error) {
+	want := make(map[string]interface{}, len(v))
+	for k, value := range v {
+		want[k] = value
+	}
+	delete(want, "value_file")
+
+	if path := v["value_file"].(string); path != "" {
+		content, err := readVariableValueFile(path)
+		if err != nil {
+			return nil, err
+		}
+		want["value"] = content
+	}
+
+	return want, nil
+}
+
+// syncVariables makes the variables at path match the variables of the resource.
+// Variables which aren't declared in the resource are removed.
+func syncVariables(client *gitlab.Client, path string, d *schema.ResourceData) error {
+	existing, err := listVariables(client, path)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]map[string]interface{}, len(existing))
+	for _, v := range existing {
+		current[variableID(v.Key, v.EnvironmentScope)] = flattenVariable(v)
+	}
+
+	declared := make(map[string]bool)
+	for _, raw := range d.Get("variable").(*schema.Set).List() {
+		want, err := expandVariablesVariable(raw.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		key := want["key"].(string)
+		environmentScope := want["environment_scope"].(string)
+
+		id := variableID(key, environmentScope)
+		if declared[id] {
+			return fmt.Errorf("variable %q is declared more than once", id)
+		}
+		declared[id] = true
+
+		options := &variableOptions{
+			Value:        gitlab.String(want["value"].(string)),
+			VariableType: stringToVariableType(want["variable_type"].(string)),
+			Protected:    gitlab.Bool(want["protected"].(bool)),
+			Masked:       gitlab.Bool(want["masked"].(bool)),
+			Description:  gitlab.String(want["description"].(string)),
+		}
+
+		got, ok := current[id]
+		if !ok {
+			log.Printf("[DEBUG] create gitlab variable %q at %s", id, path)
+
+			options.Key = gitlab.String(key)
+			options.EnvironmentScope = gitlab.String(environmentScope)
+			if err := createVariable(client, path, options); err != nil {
+				return fmt.Errorf("failed to create variable %q: %w", id, err)
+			}
+			continue
+		}
+
+		if variableAttributesEqual(got, want) {
+			continue
+		}
+
+		log.Printf("[DEBUG] update gitlab variable %q at %s", id, path)
+
+		if err := updateVariable(client, path, key, options, withEnvironmentScopeFilter(environmentScope)); err != nil {
+			return fmt.Errorf("failed to update variable %q: %w", id, err)
+		}
+	}
+
+	for _, v := range existing {
+		id := variableID(v.Key, v.EnvironmentScope)
+		if declared[id] {
+			continue
+		}
+
+		log.Printf("[DEBUG] delete gitlab variable %q at %s", id, path)
+
+		if _, err := deleteVariable(client, path, v.Key, withEnvironmentScopeFilter(v.EnvironmentScope)); err != nil {
+			return fmt.Errorf("failed to delete variable %q: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+func variableAttributesEqual(got, want map[string]interface{}) bool {
+	for k, v := range want {
+		if got[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// deleteDeclaredVariables removes the variables of the resource from path.
+func deleteDeclaredVariables(client *gitlab.Client, path string, d *schema.ResourceData) error {
+	for _, raw := range d.Get("variable").(*schema.Set).List() {
+		v := raw.(map[string]interface{})
+		key := v["key"].(string)
+		environmentScope := v["environment_scope"].(string)
+
+		log.Printf("[DEBUG] delete gitlab variable %q at %s", variableID(key, environmentScope), path)
+
+		resp, err := deleteVariable(client, path, key, withEnvironmentScopeFilter(environmentScope))
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package gitlab
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectVariables_basic(t *testing.T) {
+	ctx := testAccGitlabProjectStart(t)
+	defer ctx.finish()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGitlabProjectVariableCheckAllVariablesDestroyed(ctx),
+		Steps: []resource.TestStep{
+			// Create two variables.
+			{
+				Config: testAccGitlabProjectVariablesConfig(ctx.project.ID, `
+  variable {
+    key   = "key_a"
+    value = "value_a"
+  }
+
+  variable {
+    key           = "key_b"
+    value         = "value_b"
+    variable_type = "file"
+    protected     = true
+  }
+`),
+				Check: testAccCheckGitlabVariablesKeys(projectVariablesPath(fmt.Sprint(ctx.project.ID)), "key_a:*", "key_b:*"),
+			},
+			// Remove an undeclared variable which was created out-of-band.
+			{
+				PreConfig: func() {
+					_, _, err := ctx.client.ProjectVariables.CreateVariable(ctx.project.ID, &gitlab.CreateProjectVariableOptions{
+						Key:   gitlab.String("key_c"),
+						Value: gitlab.String("value_c"),
+					})
+					if err != nil {
+						t.Fatalf("failed to create variable: %v", err)
+					}
+				},
+				Config: testAccGitlabProjectVariablesConfig(ctx.project.ID, `
+  variable {
+    key   = "key_a"
+    value = "value_a"
+  }
+
+  variable {
+    key           = "key_b"
+    value         = "value_b"
+    variable_type = "file"
+    protected     = true
+  }
+`),
+				Check: testAccCheckGitlabVariablesKeys(projectVariablesPath(fmt.Sprint(ctx.project.ID)), "key_a:*", "key_b:*"),
+			},
+			// Update a variable, remove one and add the same key in another environment scope.
+			{
+				Config: testAccGitlabProjectVariablesConfig(ctx.project.ID, `
+  variable {
+    key         = "key_a"
+    value       = "value_a_2"
+    description = "Updated description"
+  }
+
+  variable {
+    key               = "key_a"
+    value             = "value_a_production"
+    environment_scope = "production"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabVariablesKeys(projectVariablesPath(fmt.Sprint(ctx.project.ID)), "key_a:*", "key_a:production"),
+					resource.TestCheckResourceAttr("gitlab_project_variables.foo", "variable.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_variables.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectVariables_valueFile(t *testing.T) {
+	ctx := testAccGitlabProjectStart(t)
+	defer ctx.finish()
+
+	valueFile := filepath.Join(t.TempDir(), "kubeconfig")
+	writeValueFile := func(content string) {
+		if err := ioutil.WriteFile(valueFile, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write value file: %v", err)
+		}
+	}
+	writeValueFile("apiVersion: v1\nkind: Config\n")
+
+	path := projectVariablesPath(fmt.Sprint(ctx.project.ID))
+	config := testAccGitlabProjectVariablesConfig(ctx.project.ID, fmt.Sprintf(`
+  variable {
+    key           = "KUBECONFIG"
+    value_file    = %q
+    variable_type = "file"
+  }
+`, valueFile))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccGitlabProjectVariableCheckAllVariablesDestroyed(ctx),
+		Steps: []resource.TestStep{
+			// Create a variable from the contents of a file.
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabVariableValue(path, "KUBECONFIG", "apiVersion: v1\nkind: Config\n"),
+					resource.TestCheckResourceAttr("gitlab_project_variables.foo", "value_file_hashes.KUBECONFIG:*", hashVariableValue("apiVersion: v1\nkind: Config\n")),
+				),
+			},
+			// Change the contents of the file without changing the configuration.
+			{
+				PreConfig: func() { writeValueFile("apiVersion: v1\nkind: Config\nclusters: []\n") },
+				Config:    config,
+				Check:     testAccCheckGitlabVariableValue(path, "KUBECONFIG", "apiVersion: v1\nkind: Config\nclusters: []\n"),
+			},
+			// Setting both value and value_file is not allowed.
+			{
+				Config: testAccGitlabProjectVariablesConfig(ctx.project.ID, fmt.Sprintf(`
+  variable {
+    key        = "KUBECONFIG"
+    value      = "inline"
+    value_file = %q
+  }
+`, valueFile)),
+				ExpectError: regexp.MustCompile(`only one of value and value_file can be specified for variable "KUBECONFIG:\*"`),
+			},
+		},
+	})
+}
+
+// testAccCheckGitlabVariableValue checks the value of the variable key at path.
+func testAccCheckGitlabVariableValue(path, key, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		v, _, err := getVariable(conn, path, key)
+		if err != nil {
+			return err
+		}
+		if v.Value != want {
+			return fmt.Errorf("got value %q for variable %q; want %q", v.Value, key, want)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckGitlabVariablesKeys checks that the variables at path are exactly the
+// variables with the given "key:environment_scope" IDs.
+func testAccCheckGitlabVariablesKeys(path string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		variables, err := listVariables(conn, path)
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		for _, v := range variables {
+			got = append(got, variableID(v.Key, v.EnvironmentScope))
+		}
+		sort.Strings(got)
+		sort.Strings(want)
+
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			return fmt.Errorf("got variables %v; want %v", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccGitlabProjectVariablesConfig(projectID int, variables string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project_variables" "foo" {
+  project = "%d"
+%s
+}
+`, projectID, variables)
+}
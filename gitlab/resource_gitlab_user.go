@@ -3,11 +3,14 @@ package gitlab
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	gitlab "github.com/xanzy/go-gitlab"
 )
 
@@ -18,7 +21,7 @@ func resourceGitlabUser() *schema.Resource {
 		Update: resourceGitlabUserUpdate,
 		Delete: resourceGitlabUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceGitlabUserImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,11 +75,172 @@ func resourceGitlabUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateValueFunc(userStates),
+			},
+			"on_destroy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "delete",
+				ValidateFunc: validateValueFunc([]string{"delete", "block"}),
+			},
+			"delete_contributions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"private_profile": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"bio": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"organization": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"website_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"theme_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"color_scheme_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"shared_runners_minutes_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"extra_shared_runners_minutes_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"force_random_password": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				// It is only used to create the user, so changing it later does nothing.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"namespace_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"identities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"extern_uid": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceGitlabUserSetToState(d *schema.ResourceData, user *gitlab.User) {
+func resourceGitlabUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("on_destroy", "delete")
+	d.Set("delete_contributions", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+var userStates = []string{"active", "blocked", "deactivated", "banned"}
+
+// userWithSettings represents a GitLab user including the attributes that
+// go-gitlab does not decode.
+type userWithSettings struct {
+	gitlab.User
+	NamespaceID int `json:"namespace_id"`
+}
+
+// createUserOptions represents the options to create a user including the
+// attributes that go-gitlab does not encode.
+type createUserOptions struct {
+	gitlab.CreateUserOptions
+	ThemeID                        *int `json:"theme_id,omitempty"`
+	ColorSchemeID                  *int `json:"color_scheme_id,omitempty"`
+	SharedRunnersMinutesLimit      *int `json:"shared_runners_minutes_limit,omitempty"`
+	ExtraSharedRunnersMinutesLimit *int `json:"extra_shared_runners_minutes_limit,omitempty"`
+}
+
+// modifyUserOptions represents the options to modify a user including the
+// attributes that go-gitlab does not encode.
+type modifyUserOptions struct {
+	gitlab.ModifyUserOptions
+	ThemeID                        *int `json:"theme_id,omitempty"`
+	ColorSchemeID                  *int `json:"color_scheme_id,omitempty"`
+	SharedRunnersMinutesLimit      *int `json:"shared_runners_minutes_limit,omitempty"`
+	ExtraSharedRunnersMinutesLimit *int `json:"extra_shared_runners_minutes_limit,omitempty"`
+}
+
+func getUserWithSettings(client *gitlab.Client, id int) (*userWithSettings, error) {
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("users/%d", id), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	user := new(userWithSettings)
+	if _, err := client.Do(req, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func createUser(client *gitlab.Client, options *createUserOptions) (*gitlab.User, error) {
+	req, err := client.NewRequest(http.MethodPost, "users", options, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	user := new(gitlab.User)
+	if _, err := client.Do(req, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func modifyUser(client *gitlab.Client, id int, options *modifyUserOptions) error {
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("users/%d", id), options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func resourceGitlabUserSetToState(d *schema.ResourceData, user *userWithSettings) {
 	d.Set("username", user.Username)
 	d.Set("name", user.Name)
 	d.Set("can_create_group", user.CanCreateGroup)
@@ -86,37 +250,88 @@ func resourceGitlabUserSetToState(d *schema.ResourceData, user *gitlab.User) {
 	d.Set("is_external", user.External)
 	d.Set("note", user.Note)
 	d.Set("skip_confirmation", user.ConfirmedAt != nil && !user.ConfirmedAt.IsZero())
+	d.Set("state", user.State)
+	d.Set("identities", flattenUserIdentities(user.Identities))
+	d.Set("private_profile", user.PrivateProfile)
+	d.Set("bio", user.Bio)
+	d.Set("location", user.Location)
+	d.Set("organization", user.Organization)
+	d.Set("website_url", user.WebsiteURL)
+	d.Set("theme_id", user.ThemeID)
+	d.Set("color_scheme_id", user.ColorSchemeID)
+	d.Set("shared_runners_minutes_limit", user.SharedRunnersMinutesLimit)
+	d.Set("extra_shared_runners_minutes_limit", user.ExtraSharedRunnersMinutesLimit)
+	d.Set("namespace_id", user.NamespaceID)
 }
 
 func resourceGitlabUserCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
-	options := &gitlab.CreateUserOptions{
-		Email:            gitlab.String(d.Get("email").(string)),
-		Password:         gitlab.String(d.Get("password").(string)),
-		Username:         gitlab.String(d.Get("username").(string)),
-		Name:             gitlab.String(d.Get("name").(string)),
-		ProjectsLimit:    gitlab.Int(d.Get("projects_limit").(int)),
-		Admin:            gitlab.Bool(d.Get("is_admin").(bool)),
-		CanCreateGroup:   gitlab.Bool(d.Get("can_create_group").(bool)),
-		SkipConfirmation: gitlab.Bool(d.Get("skip_confirmation").(bool)),
-		External:         gitlab.Bool(d.Get("is_external").(bool)),
-		ResetPassword:    gitlab.Bool(d.Get("reset_password").(bool)),
-		Note:             gitlab.String(d.Get("note").(string)),
+	options := &createUserOptions{
+		CreateUserOptions: gitlab.CreateUserOptions{
+			Email:               gitlab.String(d.Get("email").(string)),
+			Password:            gitlab.String(d.Get("password").(string)),
+			Username:            gitlab.String(d.Get("username").(string)),
+			Name:                gitlab.String(d.Get("name").(string)),
+			ProjectsLimit:       gitlab.Int(d.Get("projects_limit").(int)),
+			Admin:               gitlab.Bool(d.Get("is_admin").(bool)),
+			CanCreateGroup:      gitlab.Bool(d.Get("can_create_group").(bool)),
+			SkipConfirmation:    gitlab.Bool(d.Get("skip_confirmation").(bool)),
+			External:            gitlab.Bool(d.Get("is_external").(bool)),
+			ResetPassword:       gitlab.Bool(d.Get("reset_password").(bool)),
+			ForceRandomPassword: gitlab.Bool(d.Get("force_random_password").(bool)),
+			Note:                gitlab.String(d.Get("note").(string)),
+			Bio:                 gitlab.String(d.Get("bio").(string)),
+			Location:            gitlab.String(d.Get("location").(string)),
+			Organization:        gitlab.String(d.Get("organization").(string)),
+			WebsiteURL:          gitlab.String(d.Get("website_url").(string)),
+		},
+	}
+
+	if v, ok := d.GetOkExists("private_profile"); ok {
+		options.PrivateProfile = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("theme_id"); ok {
+		options.ThemeID = gitlab.Int(v.(int))
 	}
 
-	if *options.Password == "" && !*options.ResetPassword {
-		return fmt.Errorf("At least one of either password or reset_password must be defined")
+	if v, ok := d.GetOk("color_scheme_id"); ok {
+		options.ColorSchemeID = gitlab.Int(v.(int))
+	}
+
+	if v, ok := d.GetOkExists("shared_runners_minutes_limit"); ok {
+		options.SharedRunnersMinutesLimit = gitlab.Int(v.(int))
+	}
+
+	if v, ok := d.GetOkExists("extra_shared_runners_minutes_limit"); ok {
+		options.ExtraSharedRunnersMinutesLimit = gitlab.Int(v.(int))
+	}
+
+	if *options.Password == "" && !*options.ResetPassword && !*options.ForceRandomPassword {
+		return fmt.Errorf("At least one of password, reset_password or force_random_password must be defined")
 	}
 
 	log.Printf("[DEBUG] create gitlab user %q", *options.Username)
 
-	user, _, err := client.Users.CreateUser(options)
+	user, err := createUser(client, options)
 	if err != nil {
 		return err
 	}
 
 	d.SetId(fmt.Sprintf("%d", user.ID))
 
+	if v, ok := d.GetOk("identities"); ok {
+		if err := updateUserIdentities(client, user.ID, schema.NewSet(v.(*schema.Set).F, nil), v.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("state"); ok {
+		if err := updateUserState(client, user.ID, user.State, v.(string)); err != nil {
+			return fmt.Errorf("new user %d could not be set to %s: %w", user.ID, v.(string), err)
+		}
+	}
+
 	return resourceGitlabUserRead(d, meta)
 }
 
@@ -126,7 +341,7 @@ func resourceGitlabUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	id, _ := strconv.Atoi(d.Id())
 
-	user, _, err := client.Users.GetUser(id)
+	user, err := getUserWithSettings(client, id)
 	if err != nil {
 		return err
 	}
@@ -138,7 +353,7 @@ func resourceGitlabUserRead(d *schema.ResourceData, meta interface{}) error {
 func resourceGitlabUserUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 
-	options := &gitlab.ModifyUserOptions{}
+	options := &modifyUserOptions{}
 
 	if d.HasChange("name") {
 		options.Name = gitlab.String(d.Get("name").(string))
@@ -173,15 +388,64 @@ func resourceGitlabUserUpdate(d *schema.ResourceData, meta interface{}) error {
 		options.Note = gitlab.String(d.Get("note").(string))
 	}
 
+	if d.HasChange("private_profile") {
+		options.PrivateProfile = gitlab.Bool(d.Get("private_profile").(bool))
+	}
+
+	if d.HasChange("bio") {
+		options.Bio = gitlab.String(d.Get("bio").(string))
+	}
+
+	if d.HasChange("location") {
+		options.Location = gitlab.String(d.Get("location").(string))
+	}
+
+	if d.HasChange("organization") {
+		options.Organization = gitlab.String(d.Get("organization").(string))
+	}
+
+	if d.HasChange("website_url") {
+		options.WebsiteURL = gitlab.String(d.Get("website_url").(string))
+	}
+
+	if d.HasChange("theme_id") {
+		options.ThemeID = gitlab.Int(d.Get("theme_id").(int))
+	}
+
+	if d.HasChange("color_scheme_id") {
+		options.ColorSchemeID = gitlab.Int(d.Get("color_scheme_id").(int))
+	}
+
+	if d.HasChange("shared_runners_minutes_limit") {
+		options.SharedRunnersMinutesLimit = gitlab.Int(d.Get("shared_runners_minutes_limit").(int))
+	}
+
+	if d.HasChange("extra_shared_runners_minutes_limit") {
+		options.ExtraSharedRunnersMinutesLimit = gitlab.Int(d.Get("extra_shared_runners_minutes_limit").(int))
+	}
+
 	log.Printf("[DEBUG] update gitlab user %s", d.Id())
 
 	id, _ := strconv.Atoi(d.Id())
 
-	_, _, err := client.Users.ModifyUser(id, options)
-	if err != nil {
+	if err := modifyUser(client, id, options); err != nil {
 		return err
 	}
 
+	if d.HasChange("identities") {
+		oldIdentities, newIdentities := d.GetChange("identities")
+		if err := updateUserIdentities(client, id, oldIdentities.(*schema.Set), newIdentities.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("state") {
+		oldState, newState := d.GetChange("state")
+		if err := updateUserState(client, id, oldState.(string), newState.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceGitlabUserRead(d, meta)
 }
 
@@ -191,7 +455,23 @@ func resourceGitlabUserDelete(d *schema.ResourceData, meta interface{}) error {
 
 	id, _ := strconv.Atoi(d.Id())
 
-	if _, err := client.Users.DeleteUser(id); err != nil {
+	if d.Get("on_destroy").(string) == "block" {
+		state := d.Get("state").(string)
+		if state == "ldap_blocked" || state == "blocked_pending_approval" {
+			log.Printf("[DEBUG] gitlab user %s is %s already, not blocking it", d.Id(), state)
+			return nil
+		}
+
+		log.Printf("[DEBUG] Block gitlab user %s instead of deleting it", d.Id())
+		return updateUserState(client, id, state, "blocked")
+	}
+
+	var options []gitlab.RequestOptionFunc
+	if d.Get("delete_contributions").(bool) {
+		options = append(options, withHardDelete)
+	}
+
+	if _, err := client.Users.DeleteUser(id, options...); err != nil {
 		return err
 	}
 
@@ -216,3 +496,118 @@ func resourceGitlabUserDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func flattenUserIdentities(identities []*gitlab.UserIdentity) []interface{} {
+	values := make([]interface{}, 0, len(identities))
+	for _, identity := range identities {
+		values = append(values, map[string]interface{}{
+			"provider":   identity.Provider,
+			"extern_uid": identity.ExternUID,
+		})
+	}
+	return values
+}
+
+// updateUserIdentities adds or updates the identities of a user that are in new
+// and removes those of old which have no identity for their provider in new.
+func updateUserIdentities(client *gitlab.Client, id int, old, new *schema.Set) error {
+	providers := make(map[string]bool)
+	for _, raw := range new.List() {
+		identity := raw.(map[string]interface{})
+		providers[identity["provider"].(string)] = true
+	}
+
+	for _, raw := range old.Difference(new).List() {
+		provider := raw.(map[string]interface{})["provider"].(string)
+		if providers[provider] {
+			continue
+		}
+
+		log.Printf("[DEBUG] delete %s identity of gitlab user %d", provider, id)
+
+		// go-gitlab has no method to delete user identities yet.
+		req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("users/%d/identities/%s", id, url.PathEscape(provider)), nil, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := client.Do(req, nil); err != nil {
+			return fmt.Errorf("failed to delete %s identity of user %d: %w", provider, id, err)
+		}
+	}
+
+	// Modifying the provider and extern_uid of a user adds an identity for the
+	// provider, or updates the existing one.
+	for _, raw := range new.Difference(old).List() {
+		identity := raw.(map[string]interface{})
+		provider := identity["provider"].(string)
+
+		log.Printf("[DEBUG] set %s identity of gitlab user %d", provider, id)
+
+		_, _, err := client.Users.ModifyUser(id, &gitlab.ModifyUserOptions{
+			Provider:  gitlab.String(provider),
+			ExternUID: gitlab.String(identity["extern_uid"].(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set %s identity of user %d: %w", provider, id, err)
+		}
+	}
+
+	return nil
+}
+
+// updateUserState moves a user from one state to another. Users can only move
+// between blocked, deactivated and banned by becoming active first, and users
+// pending approval become active by being approved. The other states GitLab
+// sets itself, like ldap_blocked, can't be left with the API.
+func updateUserState(client *gitlab.Client, id int, from, to string) error {
+	if from == to {
+		return nil
+	}
+
+	log.Printf("[DEBUG] change state of gitlab user %d from %s to %s", id, from, to)
+
+	var err error
+	switch from {
+	case "active":
+	case "blocked":
+		err = client.Users.UnblockUser(id)
+	case "deactivated":
+		err = client.Users.ActivateUser(id)
+	case "banned":
+		err = userAction(client, id, "unban")
+	case "blocked_pending_approval":
+		err = userAction(client, id, "approve")
+	default:
+		// E.g. users blocked by LDAP can only be unblocked by LDAP.
+		return fmt.Errorf("user %d is %s, which can't be changed with the API", id, from)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to activate user %d: %w", id, err)
+	}
+
+	switch to {
+	case "blocked":
+		err = client.Users.BlockUser(id)
+	case "deactivated":
+		err = client.Users.DeactivateUser(id)
+	case "banned":
+		err = userAction(client, id, "ban")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to change state of user %d to %s: %w", id, to, err)
+	}
+
+	return nil
+}
+
+// userAction sends a user state action which go-gitlab doesn't support yet,
+// e.g. "ban" or "unban".
+func userAction(client *gitlab.Client, id int, action string) error {
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("users/%d/%s", id, action), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
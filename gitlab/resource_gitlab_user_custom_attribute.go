@@ -0,0 +1,70 @@
+package gitlab
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabUserCustomAttribute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabUserCustomAttributeCreate,
+		Read:   resourceGitlabUserCustomAttributeRead,
+		Update: resourceGitlabUserCustomAttributeUpdate,
+		Delete: resourceGitlabUserCustomAttributeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: customAttributeSchema("user_id"),
+	}
+}
+
+func userCustomAttributesAPI(client *gitlab.Client) customAttributesAPI {
+	return customAttributesAPI{
+		get:    client.CustomAttribute.GetCustomUserAttribute,
+		set:    client.CustomAttribute.SetCustomUserAttribute,
+		delete: client.CustomAttribute.DeleteCustomUserAttribute,
+	}
+}
+
+func resourceGitlabUserCustomAttributeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	user := d.Get("user_id").(int)
+	key := d.Get("key").(string)
+
+	log.Printf("[DEBUG] create gitlab user %d custom attribute %q", user, key)
+
+	if err := setCustomAttribute(userCustomAttributesAPI(client), user, d); err != nil {
+		return err
+	}
+
+	d.SetId(buildCustomAttributeID(user, key))
+
+	return resourceGitlabUserCustomAttributeRead(d, meta)
+}
+
+func resourceGitlabUserCustomAttributeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	return readCustomAttribute(userCustomAttributesAPI(client), "user_id", d)
+}
+
+func resourceGitlabUserCustomAttributeUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	user := d.Get("user_id").(int)
+
+	log.Printf("[DEBUG] update gitlab user %d custom attribute %q", user, d.Get("key").(string))
+
+	if err := setCustomAttribute(userCustomAttributesAPI(client), user, d); err != nil {
+		return err
+	}
+
+	return resourceGitlabUserCustomAttributeRead(d, meta)
+}
+
+func resourceGitlabUserCustomAttributeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	return deleteCustomAttribute(userCustomAttributesAPI(client), d)
+}
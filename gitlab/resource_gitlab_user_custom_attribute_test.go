@@ -0,0 +1,61 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGitlabUserCustomAttribute_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabCustomAttributeDestroy("gitlab_user_custom_attribute", userCustomAttributesAPI),
+		Steps: []resource.TestStep{
+			// Create a custom attribute and read it with the user data source
+			{
+				Config: testAccGitlabUserCustomAttributeConfig(rInt, "finance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user_custom_attribute.foo", "key", "cost_center"),
+					resource.TestCheckResourceAttr("gitlab_user_custom_attribute.foo", "value", "finance"),
+					resource.TestCheckResourceAttr("data.gitlab_user.foo", "custom_attributes.cost_center", "finance"),
+				),
+			},
+			// Update the value
+			{
+				Config: testAccGitlabUserCustomAttributeConfig(rInt, "engineering"),
+				Check:  resource.TestCheckResourceAttr("gitlab_user_custom_attribute.foo", "value", "engineering"),
+			},
+			{
+				ResourceName:      "gitlab_user_custom_attribute.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGitlabUserCustomAttributeConfig(rInt int, value string) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name     = "foo %[1]d"
+  username = "foo%[1]d"
+  password = "test%[1]dtt"
+  email    = "foo%[1]d@ssss.com"
+}
+
+resource "gitlab_user_custom_attribute" "foo" {
+  user_id = gitlab_user.foo.id
+  key     = "cost_center"
+  value   = "%[2]s"
+}
+
+data "gitlab_user" "foo" {
+  user_id = gitlab_user_custom_attribute.foo.user_id
+}
+`, rInt, value)
+}
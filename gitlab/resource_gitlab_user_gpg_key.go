@@ -0,0 +1,212 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+	// openpgp is frozen upstream, but it still reads public keys, which is all
+	// gpgKeyDetails needs it for.
+	//lint:ignore SA1019 GitLab doesn't return the fingerprint or the expiry date of GPG keys
+	"golang.org/x/crypto/openpgp"
+)
+
+func resourceGitlabUserGPGKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabUserGPGKeyCreate,
+		Read:   resourceGitlabUserGPGKeyRead,
+		Delete: resourceGitlabUserGPGKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressKeyWhitespaceDiff,
+			},
+			"key_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// gpgKey is a GPG key of a user. go-gitlab doesn't support GPG keys yet, so they
+// are read and written with raw requests.
+type gpgKey struct {
+	ID        int        `json:"id"`
+	Key       string     `json:"key"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func resourceGitlabUserGPGKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID := d.Get("user_id").(int)
+
+	options := map[string]interface{}{
+		"key": d.Get("key").(string),
+	}
+
+	log.Printf("[DEBUG] create gitlab user %d GPG key", userID)
+
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("users/%d/gpg_keys", userID), options, nil)
+	if err != nil {
+		return err
+	}
+
+	key := new(gpgKey)
+	if _, err := client.Do(req, key); err != nil {
+		return err
+	}
+
+	userIDString := strconv.Itoa(userID)
+	keyID := strconv.Itoa(key.ID)
+	d.SetId(buildTwoPartID(&userIDString, &keyID))
+
+	return resourceGitlabUserGPGKeyRead(d, meta)
+}
+
+func resourceGitlabUserGPGKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, keyID, err := parseUserTwoPartIntID(d.Id(), "GPG key")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab user %d GPG key %d", userID, keyID)
+
+	keys, resp, err := listUserGPGKeys(client, userID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab user %d not found so removing GPG key from state", userID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var key *gpgKey
+	for _, k := range keys {
+		if k.ID == keyID {
+			key = k
+			break
+		}
+	}
+	if key == nil {
+		log.Printf("[DEBUG] gitlab user GPG key %s not found so removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	fingerprint, expiresAt := gpgKeyDetails(key.Key)
+
+	d.Set("user_id", userID)
+	d.Set("key", key.Key)
+	d.Set("key_id", key.ID)
+	d.Set("fingerprint", fingerprint)
+	d.Set("expires_at", expiresAt)
+	d.Set("created_at", formatTime(key.CreatedAt))
+
+	return nil
+}
+
+func resourceGitlabUserGPGKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, keyID, err := parseUserTwoPartIntID(d.Id(), "GPG key")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab user %d GPG key %d", userID, keyID)
+
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("users/%d/gpg_keys/%d", userID, keyID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req, nil)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func listUserGPGKeys(client *gitlab.Client, userID int) ([]*gpgKey, *gitlab.Response, error) {
+	options := &gitlab.ListOptions{Page: 1, PerPage: 100}
+
+	var keys []*gpgKey
+	for {
+		req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("users/%d/gpg_keys", userID), options, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page []*gpgKey
+		resp, err := client.Do(req, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		keys = append(keys, page...)
+
+		if resp.NextPage == 0 {
+			return keys, resp, nil
+		}
+
+		options.Page = resp.NextPage
+	}
+}
+
+// gpgKeyDetails returns the fingerprint and the expiry date of the primary key of
+// an armored GPG public key. The GPG key API of GitLab only returns the ID, the key
+// and the creation time, so they are read from the key itself. Both are empty if
+// the key can't be parsed, and the expiry date is empty if the key doesn't expire.
+func gpgKeyDetails(key string) (string, string) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+	if err != nil || len(entities) == 0 {
+		log.Printf("[WARN] failed to parse GPG key: %v", err)
+		return "", ""
+	}
+
+	entity := entities[0]
+	fingerprint := strings.ToUpper(fmt.Sprintf("%x", entity.PrimaryKey.Fingerprint))
+
+	for _, identity := range entity.Identities {
+		sig := identity.SelfSignature
+		if sig == nil || sig.KeyLifetimeSecs == nil || *sig.KeyLifetimeSecs == 0 {
+			continue
+		}
+
+		expiresAt := entity.PrimaryKey.CreationTime.Add(time.Duration(*sig.KeyLifetimeSecs) * time.Second)
+		return fingerprint, expiresAt.UTC().Format(time.RFC3339)
+	}
+
+	return fingerprint, ""
+}
@@ -0,0 +1,148 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+	//lint:ignore SA1019 generates the test keys read by gpgKeyDetails
+	"golang.org/x/crypto/openpgp"
+	//lint:ignore SA1019 generates the test keys read by gpgKeyDetails
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func TestAccGitlabUserGPGKey_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	key, fingerprint := testGPGPublicKey(t, fmt.Sprintf("listest%d@ssss.com", rInt), 0)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabUserGPGKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabUserGPGKeyConfig(rInt, key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user_gpg_key.foo", "fingerprint", fingerprint),
+					resource.TestCheckResourceAttr("gitlab_user_gpg_key.foo", "expires_at", ""),
+					resource.TestMatchResourceAttr("gitlab_user_gpg_key.foo", "key_id", regexp.MustCompile(`^\d+$`)),
+				),
+			},
+			{
+				ResourceName:      "gitlab_user_gpg_key.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGPGKeyDetails(t *testing.T) {
+	key, fingerprint := testGPGPublicKey(t, "test@example.com", 0)
+	if gotFingerprint, gotExpiresAt := gpgKeyDetails(key); gotFingerprint != fingerprint || gotExpiresAt != "" {
+		t.Errorf("gpgKeyDetails() = %q, %q; want %q, %q", gotFingerprint, gotExpiresAt, fingerprint, "")
+	}
+
+	key, fingerprint = testGPGPublicKey(t, "test@example.com", 24*time.Hour)
+	gotFingerprint, gotExpiresAt := gpgKeyDetails(key)
+	if gotFingerprint != fingerprint {
+		t.Errorf("got fingerprint %q; want %q", gotFingerprint, fingerprint)
+	}
+	expiresAt, err := time.Parse(time.RFC3339, gotExpiresAt)
+	if err != nil {
+		t.Fatalf("got invalid expires_at %q: %v", gotExpiresAt, err)
+	}
+	if d := time.Until(expiresAt); d < 23*time.Hour || d > 25*time.Hour {
+		t.Errorf("got expires_at %s; want about a day from now", gotExpiresAt)
+	}
+
+	if gotFingerprint, gotExpiresAt := gpgKeyDetails("not a key"); gotFingerprint != "" || gotExpiresAt != "" {
+		t.Errorf("gpgKeyDetails() = %q, %q for an invalid key; want empty strings", gotFingerprint, gotExpiresAt)
+	}
+}
+
+// testGPGPublicKey generates a GPG key for email which expires after lifetime, or
+// never if it is 0, and returns the armored public key and its fingerprint.
+func testGPGPublicKey(t *testing.T, email string, lifetime time.Duration) (string, string) {
+	entity, err := openpgp.NewEntity("Test", "", email, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lifetime > 0 {
+		secs := uint32(lifetime.Seconds())
+		for _, identity := range entity.Identities {
+			identity.SelfSignature.KeyLifetimeSecs = &secs
+			if err := identity.SelfSignature.SignUserId(identity.UserId.Id, entity.PrimaryKey, entity.PrivateKey, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.String(), fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint)
+}
+
+func testAccCheckGitlabUserGPGKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_gpg_key" {
+			continue
+		}
+
+		userID, keyID, err := parseUserTwoPartIntID(rs.Primary.ID, "GPG key")
+		if err != nil {
+			return err
+		}
+
+		keys, resp, err := listUserGPGKeys(conn, userID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+			return err
+		}
+		for _, k := range keys {
+			if k.ID == keyID {
+				return fmt.Errorf("GPG key %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccGitlabUserGPGKeyConfig(rInt int, key string) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name     = "foo %[1]d"
+  username = "listest%[1]d"
+  password = "test%[1]dtt"
+  email    = "listest%[1]d@ssss.com"
+}
+
+resource "gitlab_user_gpg_key" "foo" {
+  user_id = gitlab_user.foo.id
+  key     = <<EOF
+%[2]s
+EOF
+}
+  `, rInt, key)
+}
@@ -0,0 +1,178 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var impersonationTokenScopes = []string{
+	"api",
+	"read_user",
+	"read_api",
+	"read_repository",
+	"write_repository",
+	"read_registry",
+	"write_registry",
+	"sudo",
+}
+
+func resourceGitlabUserImpersonationToken() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabUserImpersonationTokenCreate,
+		Read:   resourceGitlabUserImpersonationTokenRead,
+		Delete: resourceGitlabUserImpersonationTokenDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(impersonationTokenScopes, false),
+				},
+			},
+			"expires_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDateFunc,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"revoked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitlabUserImpersonationTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID := d.Get("user_id").(int)
+
+	options := &gitlab.CreateImpersonationTokenOptions{
+		Name:   gitlab.String(d.Get("name").(string)),
+		Scopes: stringSetToStringSlice(d.Get("scopes").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("expires_at"); ok {
+		expiresAt, err := time.Parse("2006-01-02", v.(string))
+		if err != nil {
+			return fmt.Errorf("Invalid expires_at date: %v", err)
+		}
+		options.ExpiresAt = &expiresAt
+	}
+
+	log.Printf("[DEBUG] create gitlab user %d impersonation token %q", userID, *options.Name)
+
+	token, _, err := client.Users.CreateImpersonationToken(userID, options)
+	if err != nil {
+		return err
+	}
+
+	userIDString := strconv.Itoa(userID)
+	tokenID := strconv.Itoa(token.ID)
+	d.SetId(buildTwoPartID(&userIDString, &tokenID))
+
+	// The token is only returned when it is created.
+	d.Set("token", token.Token)
+
+	return resourceGitlabUserImpersonationTokenRead(d, meta)
+}
+
+func resourceGitlabUserImpersonationTokenRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, tokenID, err := parseUserTwoPartIntID(d.Id(), "impersonation token")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab user %d impersonation token %d", userID, tokenID)
+
+	token, resp, err := client.Users.GetImpersonationToken(userID, tokenID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab user impersonation token %s not found so removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Revoked and expired tokens can't be used anymore, so they are removed from
+	// the state to plan a new token.
+	if token.Revoked || !token.Active {
+		log.Printf("[DEBUG] gitlab user impersonation token %s is revoked or expired so removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userID)
+	d.Set("name", token.Name)
+	d.Set("scopes", token.Scopes)
+	d.Set("token_id", token.ID)
+	d.Set("active", token.Active)
+	d.Set("revoked", token.Revoked)
+	d.Set("created_at", formatTime(token.CreatedAt))
+	if token.ExpiresAt != nil {
+		d.Set("expires_at", token.ExpiresAt.String())
+	} else {
+		d.Set("expires_at", "")
+	}
+
+	return nil
+}
+
+func resourceGitlabUserImpersonationTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, tokenID, err := parseUserTwoPartIntID(d.Id(), "impersonation token")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] revoke gitlab user %d impersonation token %d", userID, tokenID)
+
+	resp, err := client.Users.RevokeImpersonationToken(userID, tokenID)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabUserImpersonationToken_basic(t *testing.T) {
+	var userID int
+	var token gitlab.ImpersonationToken
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabUserImpersonationTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabUserImpersonationTokenConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserImpersonationTokenExists("gitlab_user_impersonation_token.foo", &userID, &token),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.foo", "name", fmt.Sprintf("token %d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.foo", "expires_at", "2099-12-31"),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.foo", "scopes.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.foo", "active", "true"),
+					resource.TestMatchResourceAttr("gitlab_user_impersonation_token.foo", "token", regexp.MustCompile(`^.+$`)),
+				),
+			},
+			{
+				ResourceName:            "gitlab_user_impersonation_token.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Revoke the token outside of Terraform and check that a new one is planned.
+			{
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*gitlab.Client)
+					if _, err := conn.Users.RevokeImpersonationToken(userID, token.ID); err != nil {
+						t.Fatalf("failed to revoke impersonation token: %v", err)
+					}
+				},
+				Config:             testAccGitlabUserImpersonationTokenConfig(rInt),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabUserImpersonationTokenExists(n string, userID *int, token *gitlab.ImpersonationToken) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		gotUserID, tokenID, err := parseUserTwoPartIntID(rs.Primary.ID, "impersonation token")
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*gitlab.Client)
+		gotToken, _, err := conn.Users.GetImpersonationToken(gotUserID, tokenID)
+		if err != nil {
+			return err
+		}
+
+		*userID = gotUserID
+		*token = *gotToken
+		return nil
+	}
+}
+
+func testAccCheckGitlabUserImpersonationTokenDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_impersonation_token" {
+			continue
+		}
+
+		userID, tokenID, err := parseUserTwoPartIntID(rs.Primary.ID, "impersonation token")
+		if err != nil {
+			return err
+		}
+
+		token, resp, err := conn.Users.GetImpersonationToken(userID, tokenID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+			return err
+		}
+		if !token.Revoked {
+			return fmt.Errorf("Impersonation token %s is not revoked", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGitlabUserImpersonationTokenConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name     = "foo %[1]d"
+  username = "listest%[1]d"
+  password = "test%[1]dtt"
+  email    = "listest%[1]d@ssss.com"
+}
+
+resource "gitlab_user_impersonation_token" "foo" {
+  user_id    = gitlab_user.foo.id
+  name       = "token %[1]d"
+  scopes     = ["api", "read_user"]
+  expires_at = "2099-12-31"
+}
+  `, rInt)
+}
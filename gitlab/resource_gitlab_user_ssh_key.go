@@ -0,0 +1,210 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+	"golang.org/x/crypto/ssh"
+)
+
+func resourceGitlabUserSSHKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabUserSSHKeyCreate,
+		Read:   resourceGitlabUserSSHKeyRead,
+		Delete: resourceGitlabUserSSHKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressKeyWhitespaceDiff,
+			},
+			"expires_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDateFunc,
+			},
+			"key_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// sshKey is an SSH key of a user. go-gitlab doesn't know about the expiry date of
+// SSH keys yet, so they are read and written with raw requests.
+type sshKey struct {
+	gitlab.SSHKey
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func resourceGitlabUserSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID := d.Get("user_id").(int)
+
+	options := map[string]interface{}{
+		"title": d.Get("title").(string),
+		"key":   d.Get("key").(string),
+	}
+
+	if v, ok := d.GetOk("expires_at"); ok {
+		options["expires_at"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] create gitlab user %d SSH key %q", userID, options["title"])
+
+	req, err := client.NewRequest(http.MethodPost, fmt.Sprintf("users/%d/keys", userID), options, nil)
+	if err != nil {
+		return err
+	}
+
+	key := new(sshKey)
+	if _, err := client.Do(req, key); err != nil {
+		return err
+	}
+
+	userIDString := strconv.Itoa(userID)
+	keyID := strconv.Itoa(key.ID)
+	d.SetId(buildTwoPartID(&userIDString, &keyID))
+
+	return resourceGitlabUserSSHKeyRead(d, meta)
+}
+
+func resourceGitlabUserSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, keyID, err := parseUserTwoPartIntID(d.Id(), "SSH key")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab user %d SSH key %d", userID, keyID)
+
+	// List and filter the keys of the user, as getting a single key of a user
+	// is only supported by recent versions of GitLab.
+	keys, resp, err := listUserSSHKeys(client, userID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] gitlab user %d not found so removing SSH key from state", userID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var key *sshKey
+	for _, k := range keys {
+		if k.ID == keyID {
+			key = k
+			break
+		}
+	}
+	if key == nil {
+		log.Printf("[DEBUG] gitlab user SSH key %s not found so removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userID)
+	d.Set("title", key.Title)
+	d.Set("key", key.Key)
+	d.Set("key_id", key.ID)
+	d.Set("fingerprint", sshKeyFingerprint(key.Key))
+	d.Set("created_at", formatTime(key.CreatedAt))
+	if key.ExpiresAt != nil {
+		d.Set("expires_at", key.ExpiresAt.Format("2006-01-02"))
+	} else {
+		d.Set("expires_at", "")
+	}
+
+	return nil
+}
+
+func resourceGitlabUserSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, keyID, err := parseUserTwoPartIntID(d.Id(), "SSH key")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab user %d SSH key %d", userID, keyID)
+
+	resp, err := client.Users.DeleteSSHKeyForUser(userID, keyID)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func listUserSSHKeys(client *gitlab.Client, userID int) ([]*sshKey, *gitlab.Response, error) {
+	options := &gitlab.ListOptions{Page: 1, PerPage: 100}
+
+	var keys []*sshKey
+	for {
+		req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("users/%d/keys", userID), options, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page []*sshKey
+		resp, err := client.Do(req, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		keys = append(keys, page...)
+
+		if resp.NextPage == 0 {
+			return keys, resp, nil
+		}
+
+		options.Page = resp.NextPage
+	}
+}
+
+// sshKeyFingerprint returns the SHA256 fingerprint of a public key in the
+// authorized_keys format, or an empty string if it can't be parsed.
+func sshKeyFingerprint(key string) string {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+	if err != nil {
+		log.Printf("[WARN] failed to parse SSH key: %v", err)
+		return ""
+	}
+	return ssh.FingerprintSHA256(publicKey)
+}
+
+// suppressKeyWhitespaceDiff ignores leading and trailing whitespace in keys, which
+// GitLab strips.
+func suppressKeyWhitespaceDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
@@ -0,0 +1,117 @@
+package gitlab
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestAccGitlabUserSSHKey_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	key, fingerprint := testSSHPublicKey(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabUserSSHKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabUserSSHKeyConfig(rInt, key, "2099-12-31"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user_ssh_key.foo", "title", fmt.Sprintf("key %d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_user_ssh_key.foo", "fingerprint", fingerprint),
+					resource.TestCheckResourceAttr("gitlab_user_ssh_key.foo", "expires_at", "2099-12-31"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_user_ssh_key.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSSHKeyFingerprint(t *testing.T) {
+	key, fingerprint := testSSHPublicKey(t)
+
+	if got := sshKeyFingerprint(key + " user@example.com"); got != fingerprint {
+		t.Errorf("sshKeyFingerprint() = %q; want %q", got, fingerprint)
+	}
+
+	if got := sshKeyFingerprint("not a key"); got != "" {
+		t.Errorf("sshKeyFingerprint() = %q for an invalid key; want an empty string", got)
+	}
+}
+
+// testSSHPublicKey generates an SSH key and returns the public key in the
+// authorized_keys format and its fingerprint.
+func testSSHPublicKey(t *testing.T) (string, string) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPublicKey)))
+	return key, ssh.FingerprintSHA256(sshPublicKey)
+}
+
+func testAccCheckGitlabUserSSHKeyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_ssh_key" {
+			continue
+		}
+
+		userID, keyID, err := parseUserTwoPartIntID(rs.Primary.ID, "SSH key")
+		if err != nil {
+			return err
+		}
+
+		keys, resp, err := listUserSSHKeys(conn, userID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+			return err
+		}
+		for _, k := range keys {
+			if k.ID == keyID {
+				return fmt.Errorf("SSH key %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccGitlabUserSSHKeyConfig(rInt int, key, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name     = "foo %[1]d"
+  username = "listest%[1]d"
+  password = "test%[1]dtt"
+  email    = "listest%[1]d@ssss.com"
+}
+
+resource "gitlab_user_ssh_key" "foo" {
+  user_id    = gitlab_user.foo.id
+  title      = "key %[1]d"
+  key        = "%[2]s"
+  expires_at = "%[3]s"
+}
+  `, rInt, key, expiresAt)
+}
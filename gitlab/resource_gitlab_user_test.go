@@ -2,8 +2,12 @@ package gitlab
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -56,6 +60,13 @@ func TestAccGitlabUser_basic(t *testing.T) {
 						External:         false,
 						Note:             fmt.Sprintf("note%d", rInt),
 					}),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "private_profile", "true"),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "bio", "Bio"),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "location", "Earth"),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "organization", "Acme"),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "website_url", "https://example.com"),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "theme_id", "2"),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "color_scheme_id", "3"),
 				),
 			},
 			// Update the user to put the name back
@@ -97,20 +108,155 @@ func TestAccGitlabUser_password_reset(t *testing.T) {
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckGitlabGroupDestroy,
 		Steps: []resource.TestStep{
-			// Test that either password or reset_password is needed
+			// Test that a password, reset_password or force_random_password is needed
 			{
 				Config:      testAccGitlabUserConfigWrong(rInt),
-				ExpectError: regexp.MustCompile("At least one of either password or reset_password must be defined"),
+				ExpectError: regexp.MustCompile("At least one of password, reset_password or force_random_password must be defined"),
 			},
 			// Create a user without a password
 			{
 				Config: testAccGitlabUserConfigPasswordReset(rInt),
 				Check:  testAccCheckGitlabUserExists("gitlab_user.foo", &user),
 			},
+			// Create a user with a random password
+			{
+				Config: testAccGitlabUserConfigRandomPassword(rInt + 1),
+				Check:  testAccCheckGitlabUserExists("gitlab_user.bar", &user),
+			},
+		},
+	})
+}
+
+func TestAccGitlabUser_state(t *testing.T) {
+	var user gitlab.User
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabUserBlocked(&user),
+		Steps: []resource.TestStep{
+			// Create a blocked user
+			{
+				Config: testAccGitlabUserStateConfig(rInt, "blocked"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserState(&user, "blocked"),
+				),
+			},
+			// Move the user from blocked to deactivated
+			{
+				Config: testAccGitlabUserStateConfig(rInt, "deactivated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserState(&user, "deactivated"),
+				),
+			},
+			// Activate the user
+			{
+				Config: testAccGitlabUserStateConfig(rInt, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserState(&user, "active"),
+				),
+			},
+			// Block the user outside of Terraform, it stays blocked without a configured state
+			{
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*gitlab.Client)
+					if err := conn.Users.BlockUser(user.ID); err != nil {
+						t.Fatalf("failed to block user %d: %v", user.ID, err)
+					}
+				},
+				Config: testAccGitlabUserStateConfig(rInt, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserState(&user, "blocked"),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "state", "blocked"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabUser_identities(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabUserDestroy,
+		Steps: []resource.TestStep{
+			// Create a user with two identities
+			{
+				Config: testAccGitlabUserIdentitiesConfig(rInt, `
+  identities {
+    provider   = "github"
+    extern_uid = "gh-%[1]d"
+  }
+
+  identities {
+    provider   = "google_oauth2"
+    extern_uid = "google-%[1]d"
+  }
+`),
+				Check: resource.TestCheckResourceAttr("gitlab_user.foo", "identities.#", "2"),
+			},
+			// Change the extern_uid of one identity and remove the other
+			{
+				Config: testAccGitlabUserIdentitiesConfig(rInt, `
+  identities {
+    provider   = "github"
+    extern_uid = "gh-updated-%[1]d"
+  }
+`),
+				Check: resource.TestCheckResourceAttr("gitlab_user.foo", "identities.#", "1"),
+			},
+			{
+				ResourceName:      "gitlab_user.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+			// Remove the last identity
+			{
+				Config: testAccGitlabUserIdentitiesConfig(rInt, ""),
+				Check:  resource.TestCheckResourceAttr("gitlab_user.foo", "identities.#", "0"),
+			},
 		},
 	})
 }
 
+func testAccCheckGitlabUserState(user *gitlab.User, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if user.State != want {
+			return fmt.Errorf("got state %q; want %q", user.State, want)
+		}
+		return nil
+	}
+}
+
+// testAccCheckGitlabUserBlocked checks that a user with on_destroy = "block" was
+// blocked instead of deleted, and deletes it afterwards.
+func testAccCheckGitlabUserBlocked(user *gitlab.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		got, _, err := conn.Users.GetUser(user.ID)
+		if err != nil {
+			return err
+		}
+		if got.State != "blocked" {
+			return fmt.Errorf("got state %q after destroy; want %q", got.State, "blocked")
+		}
+
+		_, err = conn.Users.DeleteUser(user.ID)
+		return err
+	}
+}
+
 func testAccCheckGitlabUserExists(n string, user *gitlab.User) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -237,6 +383,13 @@ resource "gitlab_user" "foo" {
   can_create_group = true
   is_external      = false
   note             = "note%d"
+  private_profile  = true
+  bio              = "Bio"
+  location         = "Earth"
+  organization     = "Acme"
+  website_url      = "https://example.com"
+  theme_id         = 2
+  color_scheme_id  = 3
 }
   `, rInt, rInt, rInt, rInt, rInt)
 }
@@ -252,6 +405,17 @@ resource "gitlab_user" "foo" {
   `, rInt, rInt, rInt)
 }
 
+func testAccGitlabUserConfigRandomPassword(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "bar" {
+  name                  = "bar %[1]d"
+  username              = "listest%[1]d"
+  email                 = "listest%[1]d@ssss.com"
+  force_random_password = true
+}
+  `, rInt)
+}
+
 func testAccGitlabUserConfigWrong(rInt int) string {
 	return fmt.Sprintf(`
 resource "gitlab_user" "foo" {
@@ -261,3 +425,73 @@ resource "gitlab_user" "foo" {
 }
   `, rInt, rInt, rInt)
 }
+
+func testAccGitlabUserStateConfig(rInt int, state string) string {
+	var stateConfig string
+	if state != "" {
+		stateConfig = fmt.Sprintf("state      = %q", state)
+	}
+
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name       = "foo %[1]d"
+  username   = "listest%[1]d"
+  password   = "test%[1]dtt"
+  email      = "listest%[1]d@ssss.com"
+  on_destroy = "block"
+  %[2]s
+}
+  `, rInt, stateConfig)
+}
+
+func testAccGitlabUserIdentitiesConfig(rInt int, identities string) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name     = "foo %[1]d"
+  username = "listest%[1]d"
+  password = "test%[1]dtt"
+  email    = "listest%[1]d@ssss.com"
+`+identities+`
+}
+  `, rInt)
+}
+
+func TestUpdateUserState(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasPrefix(r.URL.Path, "/api/v4/users/42/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		actions = append(actions, strings.TrimPrefix(r.URL.Path, "/api/v4/users/42/"))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client, err := gitlab.NewClient("token", gitlab.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		from, to    string
+		wantActions []string
+		wantErr     bool
+	}{
+		{"active", "blocked", []string{"block"}, false},
+		{"blocked", "deactivated", []string{"unblock", "deactivate"}, false},
+		{"blocked_pending_approval", "active", []string{"approve"}, false},
+		{"blocked_pending_approval", "blocked", []string{"approve", "block"}, false},
+		{"ldap_blocked", "active", nil, true},
+		{"ldap_blocked", "ldap_blocked", nil, false},
+	} {
+		actions = nil
+		err := updateUserState(client, 42, tc.from, tc.to)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("updateUserState(%s, %s) returned error %v; want error %t", tc.from, tc.to, err, tc.wantErr)
+		}
+		if !reflect.DeepEqual(actions, tc.wantActions) {
+			t.Errorf("updateUserState(%s, %s) sent actions %v; want %v", tc.from, tc.to, actions, tc.wantActions)
+		}
+	}
+}
@@ -1,13 +1,22 @@
 package gitlab
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	gitlab "github.com/xanzy/go-gitlab"
 )
@@ -187,6 +196,38 @@ var StringIsGitlabVariableType = func(v interface{}, k string) (s []string, es [
 	return
 }
 
+// maskedVariableValueRegexp matches the characters GitLab allows in the values of
+// masked variables: the Base64 alphabet (RFC4648) and @, :, . and ~.
+var maskedVariableValueRegexp = regexp.MustCompile(`^[a-zA-Z0-9+/=@:.~]*$`)
+
+// validateMaskedVariableValue returns an error describing the masking requirement
+// that value breaks, if any.
+// ref: https://docs.gitlab.com/ee/ci/variables/#masked-variable-requirements
+func validateMaskedVariableValue(value string) error {
+	if strings.ContainsAny(value, "\r\n") {
+		return errors.New("the value of a masked variable must be a single line")
+	}
+	if len(value) < 8 {
+		return fmt.Errorf("the value of a masked variable must be at least 8 characters long, got %d", len(value))
+	}
+	if !maskedVariableValueRegexp.MatchString(value) {
+		return errors.New("the value of a masked variable must only consist of characters from the Base64 alphabet (RFC4648) and @, :, . or ~")
+	}
+	return nil
+}
+
+// validateMaskedVariable validates the value of the variable id at plan time
+// when it is masked, as GitLab rejects values that can't be masked.
+func validateMaskedVariable(id string, masked bool, value string) error {
+	if !masked {
+		return nil
+	}
+	if err := validateMaskedVariableValue(value); err != nil {
+		return fmt.Errorf("invalid value for masked variable %q: %w", id, err)
+	}
+	return nil
+}
+
 // return the pieces of id `a:b` as a, b
 func parseTwoPartID(id string) (string, string, error) {
 	parts := strings.SplitN(id, ":", 2)
@@ -197,6 +238,47 @@ func parseTwoPartID(id string) (string, string, error) {
 	return parts[0], parts[1], nil
 }
 
+// parseTwoPartIntID parses an ID `a:b` of which b is the numeric ID of what, e.g.
+// a badge of the project or group a.
+func parseTwoPartIntID(id, what string) (string, int, error) {
+	a, b, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	n, err := parseIntID(id, b, what)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return a, n, nil
+}
+
+// parseUserTwoPartIntID parses an ID `user_id:b` of which b is the numeric ID of
+// what, e.g. an SSH key or impersonation token of the user.
+func parseUserTwoPartIntID(id, what string) (int, int, error) {
+	user, n, err := parseTwoPartIntID(id, what)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	userID, err := parseIntID(id, user, "user")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return userID, n, nil
+}
+
+// parseIntID parses part of id, the numeric ID of what.
+func parseIntID(id, part, what string) (int, error) {
+	n, err := strconv.Atoi(part)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s ID from %q: %w", what, id, err)
+	}
+	return n, nil
+}
+
 // format the strings into an id `a:b`
 func buildTwoPartID(a, b *string) string {
 	return fmt.Sprintf("%s:%s", *a, *b)
@@ -234,6 +316,109 @@ func stringSetToStringSlice(stringSet *schema.Set) *[]string {
 	return &ret
 }
 
+// pathEscape escapes a project or group ID for use in the path of a raw API
+// request, the same way go-gitlab does.
+func pathEscape(s string) string {
+	return strings.Replace(url.PathEscape(s), ".", "%2E", -1)
+}
+
+// withPermanentRemoval adds the parameters that make a delete request remove a project or
+// group which is already marked for deletion immediately, instead of after the deletion delay.
+func withPermanentRemoval(fullPath string) gitlab.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		query, err := url.ParseQuery(req.Request.URL.RawQuery)
+		if err != nil {
+			return err
+		}
+		query.Set("permanently_remove", "true")
+		query.Set("full_path", fullPath)
+		req.Request.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+// withHardDelete makes a user delete request remove the contributions of the user and the
+// groups only owned by them, instead of moving the contributions to the ghost user.
+func withHardDelete(req *retryablehttp.Request) error {
+	query, err := url.ParseQuery(req.Request.URL.RawQuery)
+	if err != nil {
+		return err
+	}
+	query.Set("hard_delete", "true")
+	req.Request.URL.RawQuery = query.Encode()
+	return nil
+}
+
+// withCustomAttributes makes a project, group or user request include the custom
+// attributes, which are only returned to administrators.
+func withCustomAttributes(req *retryablehttp.Request) error {
+	query, err := url.ParseQuery(req.Request.URL.RawQuery)
+	if err != nil {
+		return err
+	}
+	query.Set("with_custom_attributes", "true")
+	req.Request.URL.RawQuery = query.Encode()
+	return nil
+}
+
+// flattenCustomAttributes flattens custom attributes into a map of their values by key.
+func flattenCustomAttributes(attributes []*gitlab.CustomAttribute) map[string]interface{} {
+	values := make(map[string]interface{}, len(attributes))
+	for _, attribute := range attributes {
+		values[attribute.Key] = attribute.Value
+	}
+	return values
+}
+
+// updateAvatar uploads the given local file as the avatar of the project or group
+// at path, e.g. "projects/42", or removes the current avatar when file is empty.
+func updateAvatar(client *gitlab.Client, path string, file string) error {
+	if file == "" {
+		req, err := client.NewRequest(http.MethodPut, path, map[string]string{"avatar": ""}, nil)
+		if err != nil {
+			return err
+		}
+		_, err = client.Do(req, nil)
+		return err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("failed to open avatar file %q: %w", file, err)
+	}
+	defer f.Close()
+
+	b := &bytes.Buffer{}
+	w := multipart.NewWriter(b)
+
+	fw, err := w.CreateFormFile("avatar", filepath.Base(file))
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(fw, f); err != nil {
+		return err
+	}
+	w.Close()
+
+	// NewRequest only encodes JSON bodies, so use it to resolve the URL and
+	// headers and pass the form to a new request, which can rewind it on retries.
+	req, err := client.NewRequest(http.MethodPut, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	upload, err := retryablehttp.NewRequest(http.MethodPut, req.URL.String(), b.Bytes())
+	if err != nil {
+		return err
+	}
+	upload.Header = req.Header
+	upload.Header.Set("Content-Type", w.FormDataContentType())
+
+	_, err = client.Do(upload, nil)
+	return err
+}
+
 // isGitLabVersionLessThan is a SkipFunc that returns true if the provided version is lower then
 // the current version of GitLab. It only checks the major and minor version numbers, not the patch.
 func isGitLabVersionLessThan(client *gitlab.Client, version string) func() (bool, error) {
@@ -270,6 +455,54 @@ func isGitLabVersionAtLeast(client *gitlab.Client, wantVersion string) func() (b
 	}
 }
 
+// isGitLabCommunityEdition checks that GitLab is known to run the Community
+// Edition, like isGitLabVersionAtLeast checks its version. It is false when the
+// edition can't be told, e.g. for the "-pre" versions of GitLab.com.
+func isGitLabCommunityEdition(client *gitlab.Client) func() (bool, error) {
+	return func() (bool, error) {
+		req, err := client.NewRequest(http.MethodGet, "version", nil, nil)
+		if err != nil {
+			return false, err
+		}
+
+		// go-gitlab doesn't decode the enterprise flag of GitLab 15.6 and newer yet.
+		var version struct {
+			Version    string `json:"version"`
+			Enterprise *bool  `json:"enterprise"`
+		}
+		if _, err := client.Do(req, &version); err != nil {
+			return false, err
+		}
+
+		isEnterprise, known := parseGitLabEdition(version.Version, version.Enterprise)
+		if !known {
+			log.Printf("[WARN] the edition of GitLab %s is unknown", version.Version)
+		}
+
+		return known && !isEnterprise, nil
+	}
+}
+
+// parseGitLabEdition returns whether GitLab runs the Enterprise Edition, and
+// whether that is known. GitLab 15.6 and newer report it with the enterprise
+// flag, older versions with the "-ee" suffix, which the Community Edition
+// versions lack.
+func parseGitLabEdition(version string, enterprise *bool) (bool, bool) {
+	if enterprise != nil {
+		return *enterprise, true
+	}
+	if strings.HasSuffix(version, "-ee") {
+		return true, true
+	}
+
+	major, minor, err := parseVersionMajorMinor(version)
+	if err != nil || strings.Contains(version, "-") {
+		return false, false
+	}
+
+	return false, major < 15 || (major == 15 && minor < 6)
+}
+
 func parseVersionMajorMinor(version string) (int, int, error) {
 	parts := strings.SplitN(version, ".", 3)
 	if len(parts) < 2 {
@@ -288,3 +521,19 @@ func parseVersionMajorMinor(version string) (int, int, error) {
 
 	return major, minor, nil
 }
+
+// flattenISOTime formats an optional date as YYYY-MM-DD.
+func flattenISOTime(date *gitlab.ISOTime) string {
+	if date == nil {
+		return ""
+	}
+	return date.String()
+}
+
+// formatTime formats an optional time as RFC 3339.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -1,8 +1,16 @@
 package gitlab
 
 import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	gitlab "github.com/xanzy/go-gitlab"
 )
 
@@ -99,3 +107,140 @@ func TestValidateURLFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestWithPermanentRemoval(t *testing.T) {
+	req, err := retryablehttp.NewRequest(http.MethodDelete, "https://gitlab.example.com/api/v4/groups/42?foo=bar", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := withPermanentRemoval("parent/child")(req); err != nil {
+		t.Fatal(err)
+	}
+
+	query := req.URL.Query()
+	if got := query.Get("permanently_remove"); got != "true" {
+		t.Fatalf("got permanently_remove %q; want %q", got, "true")
+	}
+	if got := query.Get("full_path"); got != "parent/child" {
+		t.Fatalf("got full_path %q; want %q", got, "parent/child")
+	}
+	if got := query.Get("foo"); got != "bar" {
+		t.Fatalf("got foo %q; want %q", got, "bar")
+	}
+}
+
+func TestWithHardDelete(t *testing.T) {
+	req, err := retryablehttp.NewRequest(http.MethodDelete, "https://gitlab.example.com/api/v4/users/42", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := withHardDelete(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := req.URL.Query().Get("hard_delete"); got != "true" {
+		t.Fatalf("got hard_delete %q; want %q", got, "true")
+	}
+}
+
+func TestUpdateAvatar(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "avatar.png")
+	if err := ioutil.WriteFile(file, []byte("avatar content"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v4/projects/42" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// Fail the first upload so that the client has to send the form again.
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		f, header, err := r.FormFile("avatar")
+		if err != nil {
+			t.Errorf("failed to read avatar form file: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer f.Close()
+
+		content, _ := ioutil.ReadAll(f)
+		if header.Filename != "avatar.png" || string(content) != "avatar content" {
+			t.Errorf("got avatar %q with content %q; want %q with content %q", header.Filename, content, "avatar.png", "avatar content")
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client, err := gitlab.NewClient("token", gitlab.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := updateAvatar(client, "projects/42", file); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 2 {
+		t.Fatalf("got %d upload attempts; want 2", attempts)
+	}
+
+	if err := updateAvatar(client, "projects/42", filepath.Join(t.TempDir(), "missing.png")); !os.IsNotExist(errors.Unwrap(err)) {
+		t.Fatalf("got error %v; want a missing file error", err)
+	}
+}
+
+func TestParseGitLabEdition(t *testing.T) {
+	for _, tc := range []struct {
+		version          string
+		enterprise       *bool
+		wantIsEnterprise bool
+		wantKnown        bool
+	}{
+		{"14.3.0-ee", nil, true, true},
+		{"14.3.0", nil, false, true},
+		{"15.5.4", nil, false, true},
+		{"15.7.0", gitlab.Bool(false), false, true},
+		{"15.7.0-pre", gitlab.Bool(true), true, true},
+		{"14.3.0-pre", nil, false, false},
+		{"15.7.0", nil, false, false},
+		{"invalid", nil, false, false},
+	} {
+		isEnterprise, known := parseGitLabEdition(tc.version, tc.enterprise)
+		if isEnterprise != tc.wantIsEnterprise || known != tc.wantKnown {
+			t.Errorf("parseGitLabEdition(%q, %v) = %t, %t; want %t, %t", tc.version, tc.enterprise, isEnterprise, known, tc.wantIsEnterprise, tc.wantKnown)
+		}
+	}
+}
+
+func TestValidateMaskedVariableValue(t *testing.T) {
+	for _, tc := range []struct {
+		value   string
+		wantErr string
+	}{
+		{"c2VjcmV0LXRva2Vu", ""},
+		{"user@example.com:p4ss~w.rd", ""},
+		{"short", "at least 8 characters"},
+		{"multi\nline value", "single line"},
+		{"has spaces in it", "Base64 alphabet"},
+	} {
+		err := validateMaskedVariableValue(tc.value)
+		if tc.wantErr == "" {
+			if err != nil {
+				t.Errorf("validateMaskedVariableValue(%q) = %v; want no error", tc.value, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("validateMaskedVariableValue(%q) = %v; want error containing %q", tc.value, err, tc.wantErr)
+		}
+	}
+}
@@ -7,4 +7,5 @@ require (
 	github.com/hashicorp/terraform-plugin-sdk v1.16.0
 	github.com/mitchellh/hashstructure v1.0.0
 	github.com/xanzy/go-gitlab v0.46.0
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
 )